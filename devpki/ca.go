@@ -0,0 +1,400 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package devpki : A local certificate authority for development and test queue managers
+//
+// The CA certificate and private key, and the private keys of issued client certificates, are kept
+// in a local directory that only the current user can read. Server certificates are uploaded to the
+// queue manager together with their key, so their keys are never written to disk.
+package devpki
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+const (
+	// DefaultCommonName is the subject common name of a CA created without one.
+	DefaultCommonName = "mqcloud-go-sdk development CA"
+
+	// DefaultCAValidity is how long a newly created CA certificate is valid for.
+	DefaultCAValidity = 5 * 365 * 24 * time.Hour
+
+	// DefaultCertificateValidity is how long issued server and client certificates are valid for.
+	DefaultCertificateValidity = 365 * 24 * time.Hour
+
+	caCertificateFile = "ca.pem"
+	caKeyFile         = "ca-key.pem"
+	clientsDir        = "clients"
+	rsaKeyBits        = 2048
+	dirPermissions    = 0o700
+	keyPermissions    = 0o600
+	certPermissions   = 0o644
+)
+
+var clientNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Options : Settings for a development CA.
+type Options struct {
+	// The common name of the CA certificate. Only used when the CA is created.
+	CommonName string
+
+	// How long the CA certificate is valid for. Only used when the CA is created.
+	CAValidity time.Duration
+
+	// How long issued certificates are valid for.
+	CertificateValidity time.Duration
+}
+
+// CA : A development certificate authority backed by a local directory.
+type CA struct {
+	// The CA certificate.
+	Certificate *x509.Certificate
+
+	dir                 string
+	key                 crypto.Signer
+	certificatePEM      []byte
+	certificateValidity time.Duration
+}
+
+// Certificate : A certificate issued by a development CA.
+type Certificate struct {
+	// The issued certificate.
+	Certificate *x509.Certificate
+
+	// The PEM encoded certificate.
+	CertificatePEM []byte
+
+	// The PEM encoded (PKCS #8) private key.
+	PrivateKeyPEM []byte
+
+	// Where the private key was written, for client certificates.
+	KeyFile string
+
+	// Where the certificate was written, for client certificates.
+	CertificateFile string
+
+	caPEM []byte
+}
+
+// Bundle returns a single self-contained PEM file holding the private key, the certificate and the CA
+// certificate, as expected by CreateKeyStorePemCertificate.
+func (cert *Certificate) Bundle() []byte {
+	var bundle bytes.Buffer
+	bundle.Write(cert.PrivateKeyPEM)
+	bundle.Write(cert.CertificatePEM)
+	bundle.Write(cert.caPEM)
+	return bundle.Bytes()
+}
+
+// NewCA opens the development CA stored in dir, creating the directory and a new CA if none exists.
+// The directory is restricted to the current user, and an existing CA key that other users can read
+// is rejected.
+func NewCA(dir string, options *Options) (ca *CA, err error) {
+	if options == nil {
+		options = &Options{}
+	}
+	ca = &CA{
+		dir:                 dir,
+		certificateValidity: options.CertificateValidity,
+	}
+	if ca.certificateValidity <= 0 {
+		ca.certificateValidity = DefaultCertificateValidity
+	}
+
+	err = os.MkdirAll(dir, dirPermissions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "ca-dir-error", common.GetComponentInfo())
+		return nil, err
+	}
+	err = os.Chmod(dir, dirPermissions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "ca-dir-permissions-error", common.GetComponentInfo())
+		return nil, err
+	}
+
+	_, statErr := os.Stat(filepath.Join(dir, caKeyFile))
+	if errors.Is(statErr, os.ErrNotExist) {
+		err = ca.create(options)
+	} else {
+		err = ca.load()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Dir returns the directory that holds the CA.
+func (ca *CA) Dir() string {
+	return ca.dir
+}
+
+// CertificatePEM returns the PEM encoded CA certificate, for upload to a trust store.
+func (ca *CA) CertificatePEM() []byte {
+	return ca.certificatePEM
+}
+
+// IssueServerCertificate issues a queue manager server certificate whose subject alternative names
+// are the given hosts. The first host is also used as the subject common name.
+func (ca *CA) IssueServerCertificate(hosts []string) (cert *Certificate, err error) {
+	if len(hosts) == 0 {
+		err = core.SDKErrorf(nil, "at least one host is required for a server certificate", "no-hosts", common.GetComponentInfo())
+		return
+	}
+	template := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: hosts[0]},
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	return ca.issue(template)
+}
+
+// IssueClientCertificate issues a client certificate for the named application and writes its
+// private key and certificate to the CA directory, under clients/<name>-key.pem and clients/<name>.pem.
+func (ca *CA) IssueClientCertificate(name string) (cert *Certificate, err error) {
+	if !clientNamePattern.MatchString(name) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid client name '%s'", name), "invalid-client-name", common.GetComponentInfo())
+		return
+	}
+	cert, err = ca.issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: name},
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return
+	}
+
+	dir := filepath.Join(ca.dir, clientsDir)
+	err = os.MkdirAll(dir, dirPermissions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "clients-dir-error", common.GetComponentInfo())
+		return nil, err
+	}
+	cert.KeyFile = filepath.Join(dir, name+"-key.pem")
+	cert.CertificateFile = filepath.Join(dir, name+".pem")
+	err = writeFile(cert.KeyFile, cert.PrivateKeyPEM, keyPermissions)
+	if err == nil {
+		err = writeFile(cert.CertificateFile, append(append([]byte{}, cert.CertificatePEM...), ca.certificatePEM...), certPermissions)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-write-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// create generates a new CA key and self-signed certificate and writes them to the CA directory.
+func (ca *CA) create(options *Options) (err error) {
+	commonName := options.CommonName
+	if commonName == "" {
+		commonName = DefaultCommonName
+	}
+	validity := options.CAValidity
+	if validity <= 0 {
+		validity = DefaultCAValidity
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-key-error", common.GetComponentInfo())
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return core.SDKErrorf(err, "", "serial-error", common.GetComponentInfo())
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-create-error", common.GetComponentInfo())
+	}
+	ca.Certificate, err = x509.ParseCertificate(der)
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-parse-error", common.GetComponentInfo())
+	}
+	ca.key = key
+	ca.certificatePEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-key-encode-error", common.GetComponentInfo())
+	}
+	err = writeFile(filepath.Join(ca.dir, caKeyFile), keyPEM, keyPermissions)
+	if err == nil {
+		err = writeFile(filepath.Join(ca.dir, caCertificateFile), ca.certificatePEM, certPermissions)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-write-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// load reads an existing CA key and certificate from the CA directory.
+func (ca *CA) load() (err error) {
+	keyPath := filepath.Join(ca.dir, caKeyFile)
+	info, err := os.Stat(keyPath)
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-key-stat-error", common.GetComponentInfo())
+	}
+	if info.Mode().Perm()&0o077 != 0 {
+		return core.SDKErrorf(nil, fmt.Sprintf("CA key %s must not be accessible by other users (mode %04o)", keyPath, info.Mode().Perm()), "ca-key-permissions", common.GetComponentInfo())
+	}
+
+	keyPEM, err := os.ReadFile(keyPath) // #nosec G304
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-key-read-error", common.GetComponentInfo())
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return core.SDKErrorf(nil, fmt.Sprintf("no PEM data found in %s", keyPath), "ca-key-decode-error", common.GetComponentInfo())
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-key-parse-error", common.GetComponentInfo())
+	}
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return core.SDKErrorf(nil, fmt.Sprintf("unsupported CA key type %T", parsed), "ca-key-type-error", common.GetComponentInfo())
+	}
+
+	ca.certificatePEM, err = os.ReadFile(filepath.Join(ca.dir, caCertificateFile))
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-read-error", common.GetComponentInfo())
+	}
+	block, _ = pem.Decode(ca.certificatePEM)
+	if block == nil {
+		return core.SDKErrorf(nil, fmt.Sprintf("no PEM data found in %s", caCertificateFile), "ca-decode-error", common.GetComponentInfo())
+	}
+	ca.Certificate, err = x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return core.SDKErrorf(err, "", "ca-parse-error", common.GetComponentInfo())
+	}
+	ca.key = signer
+	return
+}
+
+// issue signs a new key pair for template with the CA key.
+func (ca *CA) issue(template *x509.Certificate) (cert *Certificate, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "key-error", common.GetComponentInfo())
+		return
+	}
+	template.SerialNumber, err = newSerialNumber()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "serial-error", common.GetComponentInfo())
+		return
+	}
+	now := time.Now()
+	template.NotBefore = now.Add(-time.Hour)
+	template.NotAfter = now.Add(ca.certificateValidity)
+	if template.NotAfter.After(ca.Certificate.NotAfter) {
+		template.NotAfter = ca.Certificate.NotAfter
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Certificate, &key.PublicKey, ca.key)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "certificate-create-error", common.GetComponentInfo())
+		return
+	}
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "key-encode-error", common.GetComponentInfo())
+		return
+	}
+	cert = &Certificate{
+		CertificatePEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		PrivateKeyPEM:  keyPEM,
+		caPEM:          ca.certificatePEM,
+	}
+	cert.Certificate, err = x509.ParseCertificate(der)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "certificate-parse-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+func encodePrivateKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+// writeFile writes data to a temporary file with the given permissions and renames it into place,
+// so that a reader never sees a partially written file or a file with looser permissions.
+func writeFile(path string, data []byte, perm os.FileMode) (err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+	if err = tmp.Chmod(perm); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return
+	}
+	if err = tmp.Close(); err != nil {
+		return
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,207 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devpki_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/devpki"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CA`, func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "devpki")
+		Expect(err).To(BeNil())
+		dir = filepath.Join(dir, "ca")
+	})
+	AfterEach(func() {
+		os.RemoveAll(filepath.Dir(dir))
+	})
+
+	It(`Creates a CA with private files and reloads it`, func() {
+		ca, err := devpki.NewCA(dir, &devpki.Options{CommonName: "test CA"})
+		Expect(err).To(BeNil())
+		Expect(ca.Certificate.IsCA).To(BeTrue())
+		Expect(ca.Certificate.Subject.CommonName).To(Equal("test CA"))
+
+		info, err := os.Stat(dir)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o700)))
+		info, err = os.Stat(filepath.Join(dir, "ca-key.pem"))
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+		reloaded, err := devpki.NewCA(dir, nil)
+		Expect(err).To(BeNil())
+		Expect(reloaded.Certificate.Equal(ca.Certificate)).To(BeTrue())
+	})
+	It(`Refuses a CA key readable by other users`, func() {
+		_, err := devpki.NewCA(dir, nil)
+		Expect(err).To(BeNil())
+		Expect(os.Chmod(filepath.Join(dir, "ca-key.pem"), 0o644)).To(Succeed())
+
+		ca, err := devpki.NewCA(dir, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(ca).To(BeNil())
+	})
+	It(`Issues server certificates with the hosts as SANs`, func() {
+		ca, err := devpki.NewCA(dir, nil)
+		Expect(err).To(BeNil())
+
+		cert, err := ca.IssueServerCertificate([]string{"qm1.example.com", "10.0.0.1"})
+		Expect(err).To(BeNil())
+		Expect(cert.Certificate.DNSNames).To(Equal([]string{"qm1.example.com"}))
+		Expect(cert.Certificate.IPAddresses).To(HaveLen(1))
+		Expect(cert.KeyFile).To(BeEmpty())
+
+		roots := x509.NewCertPool()
+		roots.AddCert(ca.Certificate)
+		_, err = cert.Certificate.Verify(x509.VerifyOptions{DNSName: "qm1.example.com", Roots: roots})
+		Expect(err).To(BeNil())
+
+		var types []string
+		rest := cert.Bundle()
+		for block, next := pem.Decode(rest); block != nil; block, next = pem.Decode(next) {
+			types = append(types, block.Type)
+		}
+		Expect(types).To(Equal([]string{"PRIVATE KEY", "CERTIFICATE", "CERTIFICATE"}))
+
+		_, err = ca.IssueServerCertificate(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Writes client keys with strict permissions`, func() {
+		ca, err := devpki.NewCA(dir, nil)
+		Expect(err).To(BeNil())
+
+		cert, err := ca.IssueClientCertificate("app1")
+		Expect(err).To(BeNil())
+		Expect(cert.Certificate.ExtKeyUsage).To(Equal([]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}))
+		info, err := os.Stat(cert.KeyFile)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+		_, err = os.Stat(cert.CertificateFile)
+		Expect(err).To(BeNil())
+
+		_, err = ca.IssueClientCertificate("../escape")
+		Expect(err).ToNot(BeNil())
+	})
+
+	Describe(`UploadToQueueManager`, func() {
+		const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+		var (
+			testServer     *httptest.Server
+			mqcloudService *mqcloudv1.MqcloudV1
+			keyStore       [][]byte
+			trustStore     []map[string]interface{}
+		)
+
+		BeforeEach(func() {
+			keyStore = nil
+			trustStore = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				path := strings.TrimPrefix(req.URL.Path, "/v1/"+serviceInstanceGuid+"/queue_managers/qm1/")
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.Method == "GET" && path == "connection_info":
+					fmt.Fprintf(res, `{"channel":[{"name":"CLOUD.APP.SVRCONN","type":"clientConnection","clientConnection":{"connection":[{"host":"qm1.example.com","port":31175}],"queueManager":"qm1"},"transmissionSecurity":{"cipherSpecification":"ANY_TLS12_OR_HIGHER"}},{"name":"CLOUD.ADMIN.SVRCONN","type":"clientConnection","clientConnection":{"connection":[{"host":"qm1.example.com","port":31175}],"queueManager":"qm1"},"transmissionSecurity":{"cipherSpecification":"ANY_TLS12_OR_HIGHER"}}]}`)
+				case req.Method == "POST" && path == "certificates/key_store":
+					Expect(req.ParseMultipartForm(1 << 20)).To(Succeed())
+					file, _, err := req.FormFile("certificate_file")
+					Expect(err).To(BeNil())
+					data, _ := io.ReadAll(file)
+					keyStore = append(keyStore, data)
+					res.WriteHeader(201)
+					fmt.Fprintf(res, `{"id":"key1","label":"%s"}`, req.FormValue("label"))
+				case req.Method == "GET" && path == "certificates/trust_store":
+					body, _ := json.Marshal(map[string]interface{}{"total_count": len(trustStore), "trust_store": trustStore})
+					_, _ = res.Write(body)
+				case req.Method == "POST" && path == "certificates/trust_store":
+					Expect(req.ParseMultipartForm(1 << 20)).To(Succeed())
+					file, _, err := req.FormFile("certificate_file")
+					Expect(err).To(BeNil())
+					data, _ := io.ReadAll(file)
+					block, _ := pem.Decode(data)
+					Expect(block).ToNot(BeNil())
+					sum := sha256.Sum256(block.Bytes)
+					entry := map[string]interface{}{
+						"id":                 fmt.Sprintf("trust%d", len(trustStore)+1),
+						"label":              req.FormValue("label"),
+						"fingerprint_sha256": strings.ToUpper(hex.EncodeToString(sum[:])),
+					}
+					trustStore = append(trustStore, entry)
+					res.WriteHeader(201)
+					body, _ := json.Marshal(entry)
+					_, _ = res.Write(body)
+				default:
+					res.WriteHeader(404)
+				}
+			}))
+
+			var err error
+			mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Uploads the server certificate and the CA once`, func() {
+			ca, err := devpki.NewCA(dir, nil)
+			Expect(err).To(BeNil())
+
+			result, err := ca.UploadToQueueManager(context.Background(), mqcloudService, serviceInstanceGuid, "qm1", nil)
+			Expect(err).To(BeNil())
+			Expect(result.TrustStoreUploaded).To(BeTrue())
+			Expect(*result.KeyStoreCertificate.Label).To(Equal(devpki.DefaultKeyStoreLabelPrefix + "_" + mqcloudv1.CertificateFingerprint(result.ServerCertificate.Certificate)[:12]))
+			Expect(*result.TrustStoreCertificate.Label).To(HavePrefix(devpki.DefaultTrustStoreLabelPrefix + "_"))
+			Expect(result.ServerCertificate.Certificate.DNSNames).To(Equal([]string{"qm1.example.com"}))
+			Expect(keyStore).To(HaveLen(1))
+			Expect(string(keyStore[0])).To(ContainSubstring("PRIVATE KEY"))
+			Expect(trustStore).To(HaveLen(1))
+
+			result, err = ca.UploadToQueueManager(context.Background(), mqcloudService, serviceInstanceGuid, "qm1", &devpki.UploadOptions{KeyStoreLabel: "second"})
+			Expect(err).To(BeNil())
+			Expect(result.TrustStoreUploaded).To(BeFalse())
+			Expect(*result.TrustStoreCertificate.ID).To(Equal("trust1"))
+			Expect(keyStore).To(HaveLen(2))
+			Expect(trustStore).To(HaveLen(1))
+		})
+	})
+})
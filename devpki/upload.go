@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devpki
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+)
+
+const (
+	// DefaultKeyStoreLabelPrefix starts the key store label of a server certificate when none is
+	// given. The label continues with "_" and the start of the certificate's fingerprint, so that
+	// every certificate gets a label of its own.
+	DefaultKeyStoreLabelPrefix = "devpki_server"
+
+	// DefaultTrustStoreLabelPrefix starts the trust store label of the CA certificate when none is
+	// given. The label continues with "_" and the start of the CA certificate's fingerprint.
+	DefaultTrustStoreLabelPrefix = "devpki_ca"
+)
+
+// UploadOptions : Settings for UploadToQueueManager.
+type UploadOptions struct {
+	// The key store label for the server certificate. Defaults to one derived from
+	// DefaultKeyStoreLabelPrefix.
+	KeyStoreLabel string
+
+	// The trust store label for the CA certificate. Defaults to one derived from
+	// DefaultTrustStoreLabelPrefix.
+	TrustStoreLabel string
+}
+
+// UploadResult : The outcome of UploadToQueueManager.
+type UploadResult struct {
+	// The server certificate that was issued.
+	ServerCertificate *Certificate
+
+	// The uploaded key store certificate.
+	KeyStoreCertificate *mqcloudv1.KeyStoreCertificateDetails
+
+	// The trust store certificate holding the CA, whether uploaded now or already present.
+	TrustStoreCertificate *mqcloudv1.TrustStoreCertificateDetails
+
+	// True if the CA certificate was uploaded by this call.
+	TrustStoreUploaded bool
+}
+
+// UploadToQueueManager issues a server certificate for every host in the queue manager's connection
+// information, uploads it with its key to the key store, and uploads the CA certificate to the trust
+// store unless a certificate with the same fingerprint is already there.
+func (ca *CA) UploadToQueueManager(ctx context.Context, client *mqcloudv1.MqcloudV1, serviceInstanceGuid string, queueManagerID string, options *UploadOptions) (result *UploadResult, err error) {
+	if client == nil {
+		err = core.SDKErrorf(nil, "client cannot be nil", "nil-client", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = &UploadOptions{}
+	}
+	info, _, err := client.GetQueueManagerConnectionInfoWithContext(ctx, &mqcloudv1.GetQueueManagerConnectionInfoOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		QueueManagerID:      core.StringPtr(queueManagerID),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "connection-info-error")
+		return
+	}
+	hosts := info.Hosts()
+	if len(hosts) == 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("queue manager %s has no connection hosts", queueManagerID), "no-hosts", common.GetComponentInfo())
+		return
+	}
+
+	result = &UploadResult{}
+	result.ServerCertificate, err = ca.IssueServerCertificate(hosts)
+	if err != nil {
+		return nil, err
+	}
+	keyStoreLabel := options.KeyStoreLabel
+	if keyStoreLabel == "" {
+		keyStoreLabel = fingerprintLabel(DefaultKeyStoreLabelPrefix, result.ServerCertificate.Certificate)
+	}
+
+	result.KeyStoreCertificate, _, err = client.CreateKeyStorePemCertificateWithContext(ctx, &mqcloudv1.CreateKeyStorePemCertificateOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		QueueManagerID:      core.StringPtr(queueManagerID),
+		Label:               core.StringPtr(keyStoreLabel),
+		CertificateFile:     io.NopCloser(bytes.NewReader(result.ServerCertificate.Bundle())),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "key-store-upload-error")
+		return nil, err
+	}
+
+	trustStore, _, err := client.ListTrustStoreCertificatesWithContext(ctx, &mqcloudv1.ListTrustStoreCertificatesOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		QueueManagerID:      core.StringPtr(queueManagerID),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "trust-store-list-error")
+		return nil, err
+	}
+	fingerprint := mqcloudv1.CertificateFingerprint(ca.Certificate)
+	for i := range trustStore.TrustStore {
+		cert := &trustStore.TrustStore[i]
+		if cert.FingerprintSha256 != nil && mqcloudv1.NormalizeFingerprint(*cert.FingerprintSha256) == fingerprint {
+			result.TrustStoreCertificate = cert
+			return
+		}
+	}
+
+	trustStoreLabel := options.TrustStoreLabel
+	if trustStoreLabel == "" {
+		trustStoreLabel = fingerprintLabel(DefaultTrustStoreLabelPrefix, ca.Certificate)
+	}
+	result.TrustStoreCertificate, _, err = client.CreateTrustStorePemCertificateWithContext(ctx, &mqcloudv1.CreateTrustStorePemCertificateOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		QueueManagerID:      core.StringPtr(queueManagerID),
+		Label:               core.StringPtr(trustStoreLabel),
+		CertificateFile:     io.NopCloser(bytes.NewReader(ca.certificatePEM)),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "trust-store-upload-error")
+		return nil, err
+	}
+	result.TrustStoreUploaded = true
+	return
+}
+
+// fingerprintLabel returns a store label made of prefix and the start of cert's fingerprint.
+func fingerprintLabel(prefix string, cert *x509.Certificate) string {
+	return prefix + "_" + mqcloudv1.CertificateFingerprint(cert)[:12]
+}
@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mqcloudotel : An implementation of mqcloudv1.Tracer that records OpenTelemetry spans
+package mqcloudotel
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer New gets from its tracer provider.
+const TracerName = "github.com/IBM/mqcloud-go-sdk/mqcloudotel"
+
+// Attributes set on the spans of operations and workflows.
+const (
+	AttributeOperationID         = attribute.Key("mqcloud.operation_id")
+	AttributeServiceInstanceGuid = attribute.Key("mqcloud.service_instance_guid")
+	AttributeQueueManagerID      = attribute.Key("mqcloud.queue_manager_id")
+	AttributeRetryCount          = attribute.Key("mqcloud.retry_count")
+	AttributeHTTPMethod          = attribute.Key("http.request.method")
+	AttributeHTTPStatusCode      = attribute.Key("http.response.status_code")
+)
+
+// Options : The New options.
+type Options struct {
+	// The provider of the tracer that starts the spans. Defaults to the global provider.
+	TracerProvider trace.TracerProvider
+
+	// The propagator that writes the trace context into request headers. Defaults to W3C trace
+	// context.
+	Propagator propagation.TextMapPropagator
+}
+
+// Tracer : Records the operations and workflows of MqcloudV1 clients as OpenTelemetry spans.
+// Pass it to MqcloudV1.SetTracer. Operations get a client span named after the operation ID, with the
+// operation ID, service instance GUID, queue manager ID, HTTP method, HTTP status code and retry
+// count as attributes and a "retry" event for each retry. Workflows get an internal span named after
+// the method.
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// New returns a tracer.
+func New(options *Options) *Tracer {
+	settings := Options{}
+	if options != nil {
+		settings = *options
+	}
+	if settings.TracerProvider == nil {
+		settings.TracerProvider = otel.GetTracerProvider()
+	}
+	if settings.Propagator == nil {
+		settings.Propagator = propagation.TraceContext{}
+	}
+	return &Tracer{
+		tracer:     settings.TracerProvider.Tracer(TracerName),
+		propagator: settings.Propagator,
+	}
+}
+
+// Start implements mqcloudv1.Tracer.
+func (tracer *Tracer) Start(ctx context.Context, start mqcloudv1.SpanStart) (context.Context, mqcloudv1.Span) {
+	var attributes []attribute.KeyValue
+	if start.QueueManager.ServiceInstanceGuid != "" {
+		attributes = append(attributes, AttributeServiceInstanceGuid.String(start.QueueManager.ServiceInstanceGuid))
+	}
+	if start.QueueManager.QueueManagerID != "" {
+		attributes = append(attributes, AttributeQueueManagerID.String(start.QueueManager.QueueManagerID))
+	}
+	kind := trace.SpanKindInternal
+	if start.Operation {
+		kind = trace.SpanKindClient
+		attributes = append(attributes, AttributeOperationID.String(start.Name), AttributeHTTPMethod.String(start.Method))
+	}
+	ctx, otelSpan := tracer.tracer.Start(ctx, start.Name, trace.WithSpanKind(kind), trace.WithAttributes(attributes...))
+	return ctx, &span{span: otelSpan, operation: start.Operation}
+}
+
+// Inject implements mqcloudv1.Tracer.
+func (tracer *Tracer) Inject(ctx context.Context, header http.Header) {
+	tracer.propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// span is a mqcloudv1.Span backed by an OpenTelemetry span.
+type span struct {
+	span      trace.Span
+	operation bool
+}
+
+// Retry implements mqcloudv1.Span.
+func (span *span) Retry(attempt int) {
+	span.span.AddEvent("retry", trace.WithAttributes(AttributeRetryCount.Int(attempt)))
+}
+
+// End implements mqcloudv1.Span.
+func (span *span) End(end mqcloudv1.SpanEnd) {
+	if span.operation {
+		span.span.SetAttributes(AttributeRetryCount.Int(end.Retries))
+		if end.StatusCode != 0 {
+			span.span.SetAttributes(AttributeHTTPStatusCode.Int(end.StatusCode))
+		}
+	}
+	if end.Err != nil {
+		span.span.RecordError(end.Err)
+		span.span.SetStatus(codes.Error, end.Err.Error())
+	}
+	span.span.End()
+}
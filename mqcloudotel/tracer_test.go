@@ -0,0 +1,161 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudotel_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudotel"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var _ = Describe(`Tracer`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		recorder       *tracetest.SpanRecorder
+		provider       *sdktrace.TracerProvider
+		traceparents   []string
+		failures       int
+	)
+
+	BeforeEach(func() {
+		traceparents = nil
+		failures = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			traceparents = append(traceparents, req.Header.Get("traceparent"))
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case failures > 0:
+				failures--
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"errors":[{"code":"unavailable","message":"try again"}]}`)
+			case strings.HasSuffix(req.URL.Path, "/queue_managers/missing"):
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors":[{"code":"not_found","message":"no such queue manager"}]}`)
+			case strings.HasSuffix(req.URL.Path, "/users"):
+				fmt.Fprint(res, `{"limit":25,"offset":0,"total_count":1,"users":[{"id":"u1","name":"alice","email":"alice@example.com"}]}`)
+			default:
+				fmt.Fprint(res, `{"id":"qm1","name":"qm1"}`)
+			}
+		}))
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		mqcloudService.SetTracer(mqcloudotel.New(&mqcloudotel.Options{TracerProvider: provider}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	attributesOf := func(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+		attributes := map[attribute.Key]attribute.Value{}
+		for _, kv := range span.Attributes() {
+			attributes[kv.Key] = kv.Value
+		}
+		return attributes
+	}
+	getQueueManager := func(ctx context.Context, id string) error {
+		_, _, err := mqcloudService.GetQueueManagerWithContext(ctx, &mqcloudv1.GetQueueManagerOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			QueueManagerID:      core.StringPtr(id),
+		})
+		return err
+	}
+
+	It(`Starts a span for each operation and propagates its context`, func() {
+		ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+		Expect(getQueueManager(ctx, "qm1")).To(Succeed())
+		parent.End()
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		span := spans[0]
+		Expect(span.Name()).To(Equal("GetQueueManager"))
+		Expect(span.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(span.Status().Code).To(Equal(codes.Unset))
+		attributes := attributesOf(span)
+		Expect(attributes[mqcloudotel.AttributeOperationID].AsString()).To(Equal("GetQueueManager"))
+		Expect(attributes[mqcloudotel.AttributeServiceInstanceGuid].AsString()).To(Equal(serviceInstanceGuid))
+		Expect(attributes[mqcloudotel.AttributeQueueManagerID].AsString()).To(Equal("qm1"))
+		Expect(attributes[mqcloudotel.AttributeHTTPStatusCode].AsInt64()).To(Equal(int64(200)))
+		Expect(attributes[mqcloudotel.AttributeRetryCount].AsInt64()).To(BeZero())
+
+		Expect(traceparents).To(HaveLen(1))
+		Expect(traceparents[0]).To(Equal(fmt.Sprintf("00-%s-%s-01", span.SpanContext().TraceID(), span.SpanContext().SpanID())))
+	})
+	It(`Records errors and retries`, func() {
+		Expect(getQueueManager(context.Background(), "missing")).ToNot(Succeed())
+		mqcloudService.EnableRetries(3, 10*time.Millisecond)
+		failures = 2
+		Expect(getQueueManager(context.Background(), "qm1")).To(Succeed())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(attributesOf(spans[0])[mqcloudotel.AttributeHTTPStatusCode].AsInt64()).To(Equal(int64(404)))
+		Expect(attributesOf(spans[1])[mqcloudotel.AttributeRetryCount].AsInt64()).To(Equal(int64(2)))
+		Expect(spans[1].Events()).To(HaveLen(2))
+		Expect(traceparents).To(HaveLen(4))
+		Expect(traceparents[3]).To(Equal(traceparents[1]))
+	})
+	It(`Makes workflows the parents of the operations they call`, func() {
+		user, err := mqcloudService.FindUserByEmail(&mqcloudv1.FindUserByEmailOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Email:               core.StringPtr("alice@example.com"),
+		})
+		Expect(err).To(BeNil())
+		Expect(*user.ID).To(Equal("u1"))
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name()).To(Equal("ListUsers"))
+		Expect(spans[1].Name()).To(Equal("FindUserByEmail"))
+		Expect(spans[0].Parent().SpanID()).To(Equal(spans[1].SpanContext().SpanID()))
+		Expect(attributesOf(spans[1])[mqcloudotel.AttributeServiceInstanceGuid].AsString()).To(Equal(serviceInstanceGuid))
+	})
+	It(`Is shared with clones and can be removed`, func() {
+		clone := mqcloudService.Clone()
+		mqcloudService.SetTracer(nil)
+		Expect(getQueueManager(context.Background(), "qm1")).To(Succeed())
+		_, _, err := clone.GetQueueManager(&mqcloudv1.GetQueueManagerOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			QueueManagerID:      core.StringPtr("qm1"),
+		})
+		Expect(err).To(BeNil())
+		Expect(recorder.Ended()).To(HaveLen(1))
+		Expect(traceparents[0]).To(BeEmpty())
+	})
+})
@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mqcloudprometheus : An implementation of mqcloudv1.Metrics that records Prometheus metrics
+package mqcloudprometheus
+
+import (
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultNamespace is the namespace of the metric names when Options.Namespace is not set.
+const DefaultNamespace = "mqcloud"
+
+// Options : The New options.
+type Options struct {
+	// The namespace of the metric names. Defaults to DefaultNamespace.
+	Namespace string
+
+	// Labels, with their values, added to every metric.
+	ConstLabels prometheus.Labels
+
+	// The buckets of the latency histogram, in seconds. Defaults to prometheus.DefBuckets.
+	Buckets []float64
+}
+
+// Metrics : Records the operations of MqcloudV1 clients as Prometheus metrics.
+// It is a prometheus.Collector; register it, then pass it to MqcloudV1.SetMetrics. The metrics are:
+//
+//   - <namespace>_request_duration_seconds: histogram of call latency by operation_id and status_class.
+//   - <namespace>_request_errors_total: calls that returned an error, by operation_id and status_class.
+//   - <namespace>_request_retries_total: retries made by the HTTP client, by operation_id.
+//   - <namespace>_pager_pages_total: pages fetched by pagers, by operation_id.
+//
+// The status_class label is the class of the final HTTP status code, such as "2xx" or "5xx", or
+// "none" when no response was received.
+type Metrics struct {
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+	retries  *prometheus.CounterVec
+	pages    *prometheus.CounterVec
+}
+
+// New returns metrics that have not been registered.
+func New(options *Options) *Metrics {
+	settings := Options{}
+	if options != nil {
+		settings = *options
+	}
+	if settings.Namespace == "" {
+		settings.Namespace = DefaultNamespace
+	}
+	if settings.Buckets == nil {
+		settings.Buckets = prometheus.DefBuckets
+	}
+	return &Metrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   settings.Namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Latency of MQ on Cloud API calls, including retries.",
+			ConstLabels: settings.ConstLabels,
+			Buckets:     settings.Buckets,
+		}, []string{"operation_id", "status_class"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   settings.Namespace,
+			Name:        "request_errors_total",
+			Help:        "MQ on Cloud API calls that returned an error.",
+			ConstLabels: settings.ConstLabels,
+		}, []string{"operation_id", "status_class"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   settings.Namespace,
+			Name:        "request_retries_total",
+			Help:        "Retries of MQ on Cloud API calls made by the HTTP client.",
+			ConstLabels: settings.ConstLabels,
+		}, []string{"operation_id"}),
+		pages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   settings.Namespace,
+			Name:        "pager_pages_total",
+			Help:        "Pages of MQ on Cloud API list results fetched by pagers.",
+			ConstLabels: settings.ConstLabels,
+		}, []string{"operation_id"}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (metrics *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	metrics.duration.Describe(ch)
+	metrics.errors.Describe(ch)
+	metrics.retries.Describe(ch)
+	metrics.pages.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (metrics *Metrics) Collect(ch chan<- prometheus.Metric) {
+	metrics.duration.Collect(ch)
+	metrics.errors.Collect(ch)
+	metrics.retries.Collect(ch)
+	metrics.pages.Collect(ch)
+}
+
+// ObserveOperation implements mqcloudv1.Metrics.
+func (metrics *Metrics) ObserveOperation(observation mqcloudv1.OperationObservation) {
+	statusClass := mqcloudv1.StatusClass(observation.StatusCode)
+	metrics.duration.WithLabelValues(observation.OperationID, statusClass).Observe(observation.Duration.Seconds())
+	if observation.Err != nil {
+		metrics.errors.WithLabelValues(observation.OperationID, statusClass).Inc()
+	}
+	if observation.Retries > 0 {
+		metrics.retries.WithLabelValues(observation.OperationID).Add(float64(observation.Retries))
+	}
+}
+
+// ObservePage implements mqcloudv1.Metrics.
+func (metrics *Metrics) ObservePage(operationID string) {
+	metrics.pages.WithLabelValues(operationID).Inc()
+}
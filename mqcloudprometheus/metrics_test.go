@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudprometheus_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudprometheus"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe(`Metrics`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		metrics        *mqcloudprometheus.Metrics
+		registry       *prometheus.Registry
+		failures       int
+	)
+
+	BeforeEach(func() {
+		failures = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case failures > 0:
+				failures--
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"errors":[{"code":"unavailable","message":"try again"}]}`)
+			case strings.HasSuffix(req.URL.Path, "/queue_managers/missing"):
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors":[{"code":"not_found","message":"no such queue manager"}]}`)
+			case strings.HasSuffix(req.URL.Path, "/users") && req.URL.Query().Get("offset") == "":
+				fmt.Fprintf(res, `{"limit":1,"offset":0,"total_count":2,"next":{"href":"%s?offset=1&limit=1"},"users":[{"id":"u1","name":"alice","email":"alice@example.com"}]}`, req.URL.Path)
+			case strings.HasSuffix(req.URL.Path, "/users"):
+				fmt.Fprint(res, `{"limit":1,"offset":1,"total_count":2,"users":[{"id":"u2","name":"bob","email":"bob@example.com"}]}`)
+			default:
+				fmt.Fprint(res, `{"id":"qm1","name":"qm1"}`)
+			}
+		}))
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		metrics = mqcloudprometheus.New(&mqcloudprometheus.Options{ConstLabels: prometheus.Labels{"service": "test"}})
+		registry = prometheus.NewRegistry()
+		Expect(registry.Register(metrics)).To(Succeed())
+		mqcloudService.SetMetrics(metrics)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	getQueueManager := func(id string) error {
+		_, _, err := mqcloudService.GetQueueManager(&mqcloudv1.GetQueueManagerOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			QueueManagerID:      core.StringPtr(id),
+		})
+		return err
+	}
+
+	It(`Records latency and errors by operation and status class`, func() {
+		Expect(getQueueManager("qm1")).To(Succeed())
+		Expect(getQueueManager("qm1")).To(Succeed())
+		Expect(getQueueManager("missing")).ToNot(Succeed())
+
+		Expect(testutil.CollectAndCount(metrics, "mqcloud_request_duration_seconds")).To(Equal(2))
+		Expect(testutil.CollectAndCompare(metrics, strings.NewReader(`
+# HELP mqcloud_request_errors_total MQ on Cloud API calls that returned an error.
+# TYPE mqcloud_request_errors_total counter
+mqcloud_request_errors_total{operation_id="GetQueueManager",service="test",status_class="4xx"} 1
+`), "mqcloud_request_errors_total")).To(Succeed())
+
+		families, err := registry.Gather()
+		Expect(err).To(BeNil())
+		for _, family := range families {
+			if family.GetName() != "mqcloud_request_duration_seconds" {
+				continue
+			}
+			counts := map[string]uint64{}
+			for _, metric := range family.GetMetric() {
+				for _, label := range metric.GetLabel() {
+					if label.GetName() == "status_class" {
+						counts[label.GetValue()] = metric.GetHistogram().GetSampleCount()
+					}
+				}
+			}
+			Expect(counts).To(Equal(map[string]uint64{"2xx": 2, "4xx": 1}))
+		}
+	})
+	It(`Records retries`, func() {
+		mqcloudService.EnableRetries(3, 10*time.Millisecond)
+		failures = 2
+		Expect(getQueueManager("qm1")).To(Succeed())
+
+		Expect(testutil.CollectAndCompare(metrics, strings.NewReader(`
+# HELP mqcloud_request_retries_total Retries of MQ on Cloud API calls made by the HTTP client.
+# TYPE mqcloud_request_retries_total counter
+mqcloud_request_retries_total{operation_id="GetQueueManager",service="test"} 2
+`), "mqcloud_request_retries_total")).To(Succeed())
+		Expect(testutil.CollectAndCount(metrics, "mqcloud_request_errors_total")).To(BeZero())
+	})
+	It(`Records pages fetched by pagers`, func() {
+		pager, err := mqcloudService.NewUsersPager(&mqcloudv1.ListUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Limit:               core.Int64Ptr(1),
+		})
+		Expect(err).To(BeNil())
+		users, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(users).To(HaveLen(2))
+
+		Expect(testutil.CollectAndCompare(metrics, strings.NewReader(`
+# HELP mqcloud_pager_pages_total Pages of MQ on Cloud API list results fetched by pagers.
+# TYPE mqcloud_pager_pages_total counter
+mqcloud_pager_pages_total{operation_id="ListUsers",service="test"} 2
+`), "mqcloud_pager_pages_total")).To(Succeed())
+	})
+})
@@ -0,0 +1,324 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/go-openapi/strfmt"
+)
+
+// maxListedDNSNames is the number of DNS names included for each certificate in a key store list.
+// Getting the certificate by ID returns them all.
+const maxListedDNSNames = 10
+
+// maxUploadSize is the largest certificate upload accepted.
+const maxUploadSize = 1 << 20
+
+var (
+	certificateLabelPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+	channelNamePattern      = regexp.MustCompile(`^[A-Za-z0-9._/%]{1,20}$`)
+)
+
+// certificate is a certificate in an emulated trust or key store.
+type certificate struct {
+	id          string
+	label       string
+	certificate *x509.Certificate
+	pem         []byte
+	fingerprint string
+	isDefault   bool
+	channels    []string
+}
+
+func (server *Server) routeCertificates(request *apiRequest, qm *queueManager, route []string) {
+	var store *[]*certificate
+	keyStore := false
+	switch route[0] {
+	case "trust_store":
+		store = &qm.trustStore
+	case "key_store":
+		store = &qm.keyStore
+		keyStore = true
+	default:
+		server.route(request, false, nil)
+		return
+	}
+	if len(route) == 1 {
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET": func(request *apiRequest) {
+				server.listCertificates(request, qm, *store, keyStore)
+			},
+			"POST": func(request *apiRequest) {
+				server.uploadCertificate(request, qm, store, keyStore)
+			},
+		})
+		return
+	}
+
+	var cert *certificate
+	for _, c := range *store {
+		if c.id == route[1] {
+			cert = c
+		}
+	}
+	if cert == nil {
+		server.writeError(request.res, http.StatusNotFound, "certificate_not_found", fmt.Sprintf("certificate %s not found", route[1]))
+		return
+	}
+	switch {
+	case len(route) == 2:
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET": func(request *apiRequest) {
+				writeJSON(request.res, http.StatusOK, server.certificateDetails(request, qm, cert, keyStore, false))
+			},
+			"DELETE": func(request *apiRequest) {
+				server.deleteCertificate(request, store, cert)
+			},
+		})
+	case len(route) == 3 && route[2] == "download":
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET": func(request *apiRequest) {
+				request.res.Header().Set("Content-Type", "application/octet-stream")
+				request.res.WriteHeader(http.StatusOK)
+				_, _ = request.res.Write(cert.pem)
+			},
+		})
+	case len(route) == 4 && keyStore && route[2] == "config" && route[3] == "ams":
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET": func(request *apiRequest) {
+				writeJSON(request.res, http.StatusOK, channelsDetails(cert))
+			},
+			"PUT": func(request *apiRequest) {
+				server.setAmsChannels(request, cert)
+			},
+		})
+	default:
+		server.route(request, false, nil)
+	}
+}
+
+func (server *Server) listCertificates(request *apiRequest, qm *queueManager, store []*certificate, keyStore bool) {
+	if keyStore {
+		collection := &mqcloudv1.KeyStoreCertificateDetailsCollection{
+			TotalCount: core.Int64Ptr(int64(len(store))),
+			KeyStore:   []mqcloudv1.KeyStoreCertificateDetails{},
+		}
+		for _, cert := range store {
+			collection.KeyStore = append(collection.KeyStore, *server.certificateDetails(request, qm, cert, true, true).(*mqcloudv1.KeyStoreCertificateDetails))
+		}
+		writeJSON(request.res, http.StatusOK, collection)
+		return
+	}
+	collection := &mqcloudv1.TrustStoreCertificateDetailsCollection{
+		TotalCount: core.Int64Ptr(int64(len(store))),
+		TrustStore: []mqcloudv1.TrustStoreCertificateDetails{},
+	}
+	for _, cert := range store {
+		collection.TrustStore = append(collection.TrustStore, *server.certificateDetails(request, qm, cert, false, true).(*mqcloudv1.TrustStoreCertificateDetails))
+	}
+	writeJSON(request.res, http.StatusOK, collection)
+}
+
+func (server *Server) uploadCertificate(request *apiRequest, qm *queueManager, store *[]*certificate, keyStore bool) {
+	if err := request.req.ParseMultipartForm(maxUploadSize); err != nil {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_body", fmt.Sprintf("the request body is not a valid multipart form: %s", err.Error()))
+		return
+	}
+	label := request.req.FormValue("label")
+	if !certificateLabelPattern.MatchString(label) {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_label", fmt.Sprintf("certificate label %q is not valid", label))
+		return
+	}
+	file, _, err := request.req.FormFile("certificate_file")
+	if err != nil {
+		server.writeError(request.res, http.StatusBadRequest, "missing_certificate_file", "the certificate_file field is required")
+		return
+	}
+	defer file.Close()
+	contents, err := io.ReadAll(file)
+	if err != nil {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_certificate", err.Error())
+		return
+	}
+
+	cert := &certificate{label: label}
+	hasKey := false
+	var certPEM bytes.Buffer
+	for block, rest := pem.Decode(contents); block != nil; block, rest = pem.Decode(rest) {
+		switch {
+		case block.Type == "CERTIFICATE":
+			if cert.certificate == nil {
+				cert.certificate, err = x509.ParseCertificate(block.Bytes)
+				if err != nil {
+					server.writeError(request.res, http.StatusBadRequest, "invalid_certificate", fmt.Sprintf("the certificate cannot be parsed: %s", err.Error()))
+					return
+				}
+			}
+			_ = pem.Encode(&certPEM, block)
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			hasKey = true
+		}
+	}
+	if cert.certificate == nil {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_certificate", "the certificate file contains no PEM certificates")
+		return
+	}
+	if keyStore && !hasKey {
+		server.writeError(request.res, http.StatusBadRequest, "missing_private_key", "key store certificates must be uploaded with their private key")
+		return
+	}
+	if !server.checkNotBusy(request, qm) {
+		return
+	}
+	sum := sha256.Sum256(cert.certificate.Raw)
+	cert.fingerprint = hex.EncodeToString(sum[:])
+	for _, existing := range *store {
+		if existing.label == label {
+			server.writeError(request.res, http.StatusConflict, "certificate_label_exists", fmt.Sprintf("a certificate labelled %s already exists", label))
+			return
+		}
+		if existing.fingerprint == cert.fingerprint {
+			server.writeError(request.res, http.StatusConflict, "certificate_exists", fmt.Sprintf("the certificate is already present as %s", existing.label))
+			return
+		}
+	}
+
+	cert.id = server.newID()
+	cert.pem = certPEM.Bytes()
+	cert.isDefault = keyStore && len(*store) == 0
+	*store = append(*store, cert)
+	writeJSON(request.res, http.StatusCreated, server.certificateDetails(request, qm, cert, keyStore, false))
+}
+
+func (server *Server) deleteCertificate(request *apiRequest, store *[]*certificate, cert *certificate) {
+	remaining := []*certificate{}
+	for _, c := range *store {
+		if c != cert {
+			remaining = append(remaining, c)
+		}
+	}
+	if cert.isDefault && len(remaining) > 0 {
+		remaining[0].isDefault = true
+	}
+	*store = remaining
+	request.res.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) setAmsChannels(request *apiRequest, cert *certificate) {
+	var body struct {
+		Channels []struct {
+			Name string `json:"name"`
+		} `json:"channels"`
+		UpdateStrategy string `json:"update_strategy"`
+	}
+	if !server.decodeBody(request, &body) {
+		return
+	}
+	names := map[string]bool{}
+	for _, channel := range body.Channels {
+		if !channelNamePattern.MatchString(channel.Name) {
+			server.writeError(request.res, http.StatusBadRequest, "invalid_channel_name", fmt.Sprintf("channel name %q is not valid", channel.Name))
+			return
+		}
+		names[channel.Name] = true
+	}
+	switch body.UpdateStrategy {
+	case mqcloudv1.SetCertificateAmsChannelsOptions_UpdateStrategy_Append:
+		for _, name := range cert.channels {
+			names[name] = true
+		}
+	case "", mqcloudv1.SetCertificateAmsChannelsOptions_UpdateStrategy_Replace:
+	default:
+		server.writeError(request.res, http.StatusBadRequest, "invalid_update_strategy", fmt.Sprintf("update strategy %q is not valid", body.UpdateStrategy))
+		return
+	}
+	cert.channels = make([]string, 0, len(names))
+	for name := range names {
+		cert.channels = append(cert.channels, name)
+	}
+	sort.Strings(cert.channels)
+	writeJSON(request.res, http.StatusOK, channelsDetails(cert))
+}
+
+// certificateDetails returns the JSON model of a certificate. Lists include at most
+// maxListedDNSNames DNS names for each key store certificate.
+func (server *Server) certificateDetails(request *apiRequest, qm *queueManager, cert *certificate, keyStore bool, listed bool) interface{} {
+	href := request.base + "/queue_managers/" + qm.id + "/certificates/trust_store/" + cert.id
+	if keyStore {
+		href = request.base + "/queue_managers/" + qm.id + "/certificates/key_store/" + cert.id
+	}
+	issued := strfmt.DateTime(cert.certificate.NotBefore.UTC())
+	expiry := strfmt.DateTime(cert.certificate.NotAfter.UTC())
+	if !keyStore {
+		return &mqcloudv1.TrustStoreCertificateDetails{
+			ID:                core.StringPtr(cert.id),
+			Label:             core.StringPtr(cert.label),
+			CertificateType:   core.StringPtr(mqcloudv1.TrustStoreCertificateDetails_CertificateType_TrustStore),
+			FingerprintSha256: core.StringPtr(cert.fingerprint),
+			SubjectDn:         core.StringPtr(cert.certificate.Subject.String()),
+			SubjectCn:         core.StringPtr(cert.certificate.Subject.CommonName),
+			IssuerDn:          core.StringPtr(cert.certificate.Issuer.String()),
+			IssuerCn:          core.StringPtr(cert.certificate.Issuer.CommonName),
+			Issued:            &issued,
+			Expiry:            &expiry,
+			Trusted:           core.BoolPtr(true),
+			Href:              core.StringPtr(href),
+		}
+	}
+	dnsNames := append([]string{}, cert.certificate.DNSNames...)
+	if listed && len(dnsNames) > maxListedDNSNames {
+		dnsNames = dnsNames[:maxListedDNSNames]
+	}
+	return &mqcloudv1.KeyStoreCertificateDetails{
+		ID:                 core.StringPtr(cert.id),
+		Label:              core.StringPtr(cert.label),
+		CertificateType:    core.StringPtr(mqcloudv1.KeyStoreCertificateDetails_CertificateType_KeyStore),
+		FingerprintSha256:  core.StringPtr(cert.fingerprint),
+		SubjectDn:          core.StringPtr(cert.certificate.Subject.String()),
+		SubjectCn:          core.StringPtr(cert.certificate.Subject.CommonName),
+		IssuerDn:           core.StringPtr(cert.certificate.Issuer.String()),
+		IssuerCn:           core.StringPtr(cert.certificate.Issuer.CommonName),
+		Issued:             &issued,
+		Expiry:             &expiry,
+		IsDefault:          core.BoolPtr(cert.isDefault),
+		DnsNamesTotalCount: core.Int64Ptr(int64(len(cert.certificate.DNSNames))),
+		DnsNames:           dnsNames,
+		Href:               core.StringPtr(href),
+		Config:             &mqcloudv1.CertificateConfiguration{Ams: channelsDetails(cert)},
+	}
+}
+
+func channelsDetails(cert *certificate) *mqcloudv1.ChannelsDetails {
+	details := &mqcloudv1.ChannelsDetails{Channels: []mqcloudv1.ChannelDetails{}}
+	for _, name := range cert.channels {
+		details.Channels = append(details.Channels, mqcloudv1.ChannelDetails{Name: core.StringPtr(name)})
+	}
+	return details
+}
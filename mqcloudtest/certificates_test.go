@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/devpki"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Certificates`, func() {
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		queueManager   *mqcloudv1.QueueManager
+		ca             *devpki.CA
+		dir            string
+	)
+
+	BeforeEach(func() {
+		clock := mqcloudtest.NewManualClock(time.Now())
+		_, testServer, mqcloudService = startServer(&mqcloudtest.Options{Clock: clock})
+		task, _, err := mqcloudService.CreateQueueManager(mqcloudService.NewCreateQueueManagerOptions(serviceInstanceGuid, "QM1", "reserved-eu-de-cluster-f884", "small"))
+		Expect(err).To(BeNil())
+		queueManager = mqcloudService.Instance(serviceInstanceGuid).QueueManager(*task.QueueManagerID)
+		clock.Advance(mqcloudtest.DefaultDeployDuration + mqcloudtest.DefaultStartDuration)
+
+		dir, err = os.MkdirTemp("", "mqcloudtest")
+		Expect(err).To(BeNil())
+		ca, err = devpki.NewCA(filepath.Join(dir, "ca"), nil)
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(dir)
+	})
+
+	It(`Accepts uploads from devpki and reports matching hostnames`, func() {
+		result, err := ca.UploadToQueueManager(context.Background(), mqcloudService, serviceInstanceGuid, queueManager.ID(), nil)
+		Expect(err).To(BeNil())
+		Expect(result.TrustStoreUploaded).To(BeTrue())
+		Expect(*result.KeyStoreCertificate.IsDefault).To(BeTrue())
+
+		result, err = ca.UploadToQueueManager(context.Background(), mqcloudService, serviceInstanceGuid, queueManager.ID(), &devpki.UploadOptions{KeyStoreLabel: "second"})
+		Expect(err).To(BeNil())
+		Expect(result.TrustStoreUploaded).To(BeFalse())
+		Expect(*result.KeyStoreCertificate.IsDefault).To(BeFalse())
+
+		report, err := queueManager.CheckKeyStoreHostnames()
+		Expect(err).To(BeNil())
+		Expect(report.DefaultFailures()).To(BeEmpty())
+
+		download, _, err := queueManager.TrustStoreCertificate(*result.TrustStoreCertificate.ID).Download(nil)
+		Expect(err).To(BeNil())
+		contents, err := io.ReadAll(download)
+		Expect(err).To(BeNil())
+		Expect(contents).To(Equal(ca.CertificatePEM()))
+	})
+	It(`Rejects duplicates and key store uploads without a key`, func() {
+		_, response, err := queueManager.CreateTrustStorePemCertificate(&mqcloudv1.CreateTrustStorePemCertificateOptions{
+			Label:           core.StringPtr("ca"),
+			CertificateFile: io.NopCloser(bytes.NewReader(ca.CertificatePEM())),
+		})
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		_, response, err = queueManager.CreateTrustStorePemCertificate(&mqcloudv1.CreateTrustStorePemCertificateOptions{
+			Label:           core.StringPtr("ca_again"),
+			CertificateFile: io.NopCloser(bytes.NewReader(ca.CertificatePEM())),
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		_, response, err = queueManager.CreateKeyStorePemCertificate(&mqcloudv1.CreateKeyStorePemCertificateOptions{
+			Label:           core.StringPtr("server"),
+			CertificateFile: io.NopCloser(bytes.NewReader(ca.CertificatePEM())),
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+	})
+	It(`Lists a limited number of DNS names and keeps AMS channels`, func() {
+		var hosts []string
+		for i := 0; i < 12; i++ {
+			hosts = append(hosts, fmt.Sprintf("host%d.example.com", i))
+		}
+		cert, err := ca.IssueServerCertificate(hosts)
+		Expect(err).To(BeNil())
+		uploaded, _, err := queueManager.CreateKeyStorePemCertificate(&mqcloudv1.CreateKeyStorePemCertificateOptions{
+			Label:           core.StringPtr("server"),
+			CertificateFile: io.NopCloser(bytes.NewReader(cert.Bundle())),
+		})
+		Expect(err).To(BeNil())
+		Expect(uploaded.DnsNames).To(HaveLen(12))
+
+		list, _, err := queueManager.ListKeyStoreCertificates(nil)
+		Expect(err).To(BeNil())
+		Expect(*list.KeyStore[0].DnsNamesTotalCount).To(BeEquivalentTo(12))
+		Expect(list.KeyStore[0].DnsNames).To(HaveLen(10))
+
+		certificate := queueManager.Certificate(*uploaded.ID)
+		channels := func(names ...string) []mqcloudv1.ChannelDetails {
+			var details []mqcloudv1.ChannelDetails
+			for _, name := range names {
+				details = append(details, mqcloudv1.ChannelDetails{Name: core.StringPtr(name)})
+			}
+			return details
+		}
+		_, _, err = certificate.SetAmsChannels(&mqcloudv1.SetCertificateAmsChannelsOptions{Channels: channels("B.SVRCONN")})
+		Expect(err).To(BeNil())
+		result, _, err := certificate.SetAmsChannels(&mqcloudv1.SetCertificateAmsChannelsOptions{
+			Channels:       channels("A.SVRCONN"),
+			UpdateStrategy: core.StringPtr(mqcloudv1.SetCertificateAmsChannelsOptions_UpdateStrategy_Append),
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Channels).To(Equal(channels("A.SVRCONN", "B.SVRCONN")))
+
+		result, _, err = certificate.SetAmsChannels(&mqcloudv1.SetCertificateAmsChannelsOptions{
+			Channels:       channels("C.SVRCONN"),
+			UpdateStrategy: core.StringPtr(mqcloudv1.SetCertificateAmsChannelsOptions_UpdateStrategy_Replace),
+		})
+		Expect(err).To(BeNil())
+		result, _, err = certificate.GetAmsChannels(nil)
+		Expect(err).To(BeNil())
+		Expect(result.Channels).To(Equal(channels("C.SVRCONN")))
+
+		response, err := certificate.Delete(nil)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+		_, response, err = certificate.Get(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+})
@@ -0,0 +1,39 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest_test
+
+import (
+	"time"
+
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ManualClock`, func() {
+	It(`Moves only when advanced or set`, func() {
+		start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		clock := mqcloudtest.NewManualClock(start)
+		Expect(clock.Now()).To(Equal(start))
+
+		clock.Advance(90 * time.Second)
+		Expect(clock.Now()).To(Equal(start.Add(90 * time.Second)))
+
+		clock.Set(start)
+		Expect(clock.Now()).To(Equal(start))
+	})
+})
@@ -0,0 +1,386 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Latency distributions for FaultRule.Latency.
+const (
+	LatencyFixed       = "fixed"
+	LatencyUniform     = "uniform"
+	LatencyNormal      = "normal"
+	LatencyExponential = "exponential"
+)
+
+// DefaultFaultTimeout is how long a request picked for a timeout is held when the rule sets no
+// Timeout. The request is released early if the client gives up.
+const DefaultFaultTimeout = time.Minute
+
+// Latency : A distribution of delays added to responses.
+type Latency struct {
+	// One of LatencyFixed, LatencyUniform, LatencyNormal or LatencyExponential. Defaults to
+	// LatencyUniform when Max is set and LatencyFixed otherwise.
+	Distribution string
+
+	// The bounds of the delay. A fixed delay is Min. Samples from the other distributions are clipped
+	// to the bounds when Max is set.
+	Min time.Duration
+	Max time.Duration
+
+	// The mean of normal and exponential delays, and the standard deviation of normal delays.
+	Mean   time.Duration
+	StdDev time.Duration
+}
+
+// Sample returns a delay drawn from the distribution.
+func (latency *Latency) Sample(random *rand.Rand) time.Duration {
+	distribution := latency.Distribution
+	if distribution == "" {
+		distribution = LatencyFixed
+		if latency.Max > 0 {
+			distribution = LatencyUniform
+		}
+	}
+	var d time.Duration
+	switch distribution {
+	case LatencyUniform:
+		d = latency.Min
+		if latency.Max > latency.Min {
+			d += time.Duration(random.Int63n(int64(latency.Max - latency.Min)))
+		}
+	case LatencyNormal:
+		d = time.Duration(random.NormFloat64()*float64(latency.StdDev)) + latency.Mean
+	case LatencyExponential:
+		d = time.Duration(random.ExpFloat64() * float64(latency.Mean))
+	default:
+		d = latency.Min
+	}
+	if d < latency.Min {
+		d = latency.Min
+	}
+	if latency.Max > 0 && d > latency.Max {
+		d = latency.Max
+	}
+	return d
+}
+
+// FaultRule : The faults to inject into requests that match a route and method.
+// Faults are considered in this order: the next entry of Statuses, a timeout, an error, then
+// truncated or malformed bodies. Latency is added before any of them.
+type FaultRule struct {
+	// The HTTP method to match. Empty matches any method.
+	Method string
+
+	// The path to match, such as "/v1/*/queue_managers/{id}/status". A segment of "*" or "{name}"
+	// matches any single segment and a final "**" matches the rest of the path. Empty matches any path.
+	Route string
+
+	// Statuses returned, in order, to the first matching requests. A zero entry lets that request
+	// through. Once the sequence is used up the other settings apply.
+	Statuses []int
+
+	// The probability that a request gets an error response.
+	ErrorRate float64
+
+	// The statuses used for error responses, picked at random. Defaults to 500.
+	ErrorStatuses []int
+
+	// The Retry-After header sent with 429 and 503 responses, if set.
+	RetryAfter time.Duration
+
+	// The probability that a request is held until Timeout passes or the client gives up, then
+	// answered with 504.
+	TimeoutRate float64
+	Timeout     time.Duration
+
+	// The probability that a response body is cut short and the connection dropped.
+	TruncateRate float64
+
+	// The probability that a response body is replaced with invalid JSON.
+	MalformedJSONRate float64
+
+	// The delay added to each matching request.
+	Latency *Latency
+}
+
+// matches reports whether the rule applies to a request.
+func (rule *FaultRule) matches(req *http.Request) bool {
+	if rule.Method != "" && !strings.EqualFold(rule.Method, req.Method) {
+		return false
+	}
+	if rule.Route == "" {
+		return true
+	}
+	pattern := strings.Split(strings.Trim(rule.Route, "/"), "/")
+	path := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, segment := range pattern {
+		if segment == "**" && i == len(pattern)-1 {
+			return true
+		}
+		if i >= len(path) {
+			return false
+		}
+		if segment != "*" && !(strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")) && segment != path[i] {
+			return false
+		}
+	}
+	return len(pattern) == len(path)
+}
+
+// FaultOptions : Settings for NewFaultInjector.
+type FaultOptions struct {
+	// The seed for the random choices. Each rule has its own sequence derived from the seed, so a
+	// rule's faults do not depend on traffic to other routes.
+	Seed int64
+
+	// The rules, tried in order. The first matching rule applies.
+	Rules []FaultRule
+
+	// Sleep waits for a delay, returning early with the context's error if it is done. Defaults to a
+	// timer; tests can replace it to avoid real delays.
+	Sleep func(ctx context.Context, d time.Duration) error
+}
+
+// FaultStats : Counts of the faults a FaultInjector has injected.
+type FaultStats struct {
+	Requests  int
+	Errors    int
+	Timeouts  int
+	Truncated int
+	Malformed int
+	Delayed   int
+}
+
+// FaultInjector : An http.Handler that wraps another handler, such as a Server, and injects the
+// faults described by its rules. With requests sent one at a time, the faults are the same on every
+// run with the same seed. It is safe for concurrent use.
+type FaultInjector struct {
+	handler http.Handler
+	rules   []*ruleState
+	sleep   func(ctx context.Context, d time.Duration) error
+
+	mutex sync.Mutex
+	stats FaultStats
+}
+
+// ruleState is a rule with its own random sequence and count of matched requests.
+type ruleState struct {
+	FaultRule
+	random  *rand.Rand
+	matched int
+}
+
+// faultPlan is what the injector will do to one request.
+type faultPlan struct {
+	delay     time.Duration
+	status    int
+	timeout   bool
+	truncate  bool
+	malformed bool
+
+	retryAfter time.Duration
+}
+
+// NewFaultInjector returns a handler that injects faults into requests before passing them to handler.
+func NewFaultInjector(handler http.Handler, options *FaultOptions) *FaultInjector {
+	if options == nil {
+		options = &FaultOptions{}
+	}
+	injector := &FaultInjector{handler: handler, sleep: options.Sleep}
+	if injector.sleep == nil {
+		injector.sleep = sleep
+	}
+	for i, rule := range options.Rules {
+		injector.rules = append(injector.rules, &ruleState{
+			FaultRule: rule,
+			random:    rand.New(rand.NewSource(options.Seed + int64(i)*7919)),
+		})
+	}
+	return injector
+}
+
+// Stats returns the counts of requests seen and faults injected so far.
+func (injector *FaultInjector) Stats() FaultStats {
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+	return injector.stats
+}
+
+// plan picks the faults for a request. Every random number a rule may need is drawn for each request,
+// whether it is used or not, so each rule's sequence advances the same way on every run.
+func (injector *FaultInjector) plan(req *http.Request) (plan faultPlan) {
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+	injector.stats.Requests++
+
+	var rule *ruleState
+	for _, r := range injector.rules {
+		if r.matches(req) {
+			rule = r
+			break
+		}
+	}
+	if rule == nil {
+		return
+	}
+	n := rule.matched
+	rule.matched++
+
+	var delay time.Duration
+	if rule.Latency != nil {
+		delay = rule.Latency.Sample(rule.random)
+	}
+	timeoutDraw := rule.random.Float64()
+	errorDraw := rule.random.Float64()
+	errorPick := rule.random.Intn(math.MaxInt32)
+	truncateDraw := rule.random.Float64()
+	malformedDraw := rule.random.Float64()
+
+	plan.delay = delay
+	switch {
+	case n < len(rule.Statuses) && rule.Statuses[n] != 0:
+		plan.status = rule.Statuses[n]
+	case n < len(rule.Statuses):
+	case timeoutDraw < rule.TimeoutRate:
+		plan.timeout = true
+	case errorDraw < rule.ErrorRate:
+		plan.status = http.StatusInternalServerError
+		if len(rule.ErrorStatuses) > 0 {
+			plan.status = rule.ErrorStatuses[errorPick%len(rule.ErrorStatuses)]
+		}
+	case truncateDraw < rule.TruncateRate:
+		plan.truncate = true
+	case malformedDraw < rule.MalformedJSONRate:
+		plan.malformed = true
+	}
+
+	if plan.delay > 0 {
+		injector.stats.Delayed++
+	}
+	switch {
+	case plan.status != 0:
+		injector.stats.Errors++
+	case plan.timeout:
+		injector.stats.Timeouts++
+	case plan.truncate:
+		injector.stats.Truncated++
+	case plan.malformed:
+		injector.stats.Malformed++
+	}
+	if plan.status == http.StatusTooManyRequests || plan.status == http.StatusServiceUnavailable {
+		plan.retryAfter = rule.RetryAfter
+	}
+	if plan.timeout {
+		plan.delay += rule.Timeout
+		if rule.Timeout == 0 {
+			plan.delay += DefaultFaultTimeout
+		}
+	}
+	return
+}
+
+// ServeHTTP injects the planned faults for the request, passing it on to the wrapped handler unless
+// it is answered with an injected error.
+func (injector *FaultInjector) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	plan := injector.plan(req)
+	if plan.delay > 0 {
+		if err := injector.sleep(req.Context(), plan.delay); err != nil {
+			return
+		}
+	}
+	switch {
+	case plan.timeout:
+		writeFault(res, http.StatusGatewayTimeout, "the request timed out", 0)
+	case plan.status != 0:
+		writeFault(res, plan.status, fmt.Sprintf("injected %d response", plan.status), plan.retryAfter)
+	case plan.truncate || plan.malformed:
+		recorder := httptest.NewRecorder()
+		injector.handler.ServeHTTP(recorder, req)
+		for name, values := range recorder.Header() {
+			res.Header()[name] = values
+		}
+		body := recorder.Body.Bytes()
+		if plan.malformed {
+			body = append([]byte(`{"malformed": `), body[:len(body)/2]...)
+			res.Header().Set("Content-Length", strconv.Itoa(len(body)))
+			res.WriteHeader(recorder.Code)
+			_, _ = res.Write(body)
+			return
+		}
+		writeTruncated(res, recorder.Code, body)
+	default:
+		injector.handler.ServeHTTP(res, req)
+	}
+}
+
+// writeTruncated sends the status, headers and first half of body, with a Content-Length for all of
+// it, then drops the connection. It takes the connection over to close it straight after the partial
+// write; where that is not possible, such as over HTTP/2, it returns after the short write and leaves
+// the server to end the response, which the client sees as a body shorter than its Content-Length.
+func writeTruncated(res http.ResponseWriter, status int, body []byte) {
+	res.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	partial := body[:len(body)/2]
+	if hijacker, ok := res.(http.Hijacker); ok {
+		if conn, buffer, err := hijacker.Hijack(); err == nil {
+			defer conn.Close()
+			res.Header().Set("Connection", "close")
+			fmt.Fprintf(buffer, "HTTP/1.1 %d %s\r\n", status, http.StatusText(status))
+			_ = res.Header().Write(buffer)
+			_, _ = buffer.WriteString("\r\n")
+			_, _ = buffer.Write(partial)
+			_ = buffer.Flush()
+			return
+		}
+	}
+	res.WriteHeader(status)
+	_, _ = res.Write(partial)
+}
+
+// writeFault writes an injected error response in the format used by the service.
+func writeFault(res http.ResponseWriter, status int, message string, retryAfter time.Duration) {
+	if retryAfter > 0 {
+		res.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+	body := fmt.Sprintf(`{"errors":[{"code":"injected_fault","message":%q}],"trace":"mqcloudtest-fault","status_code":%d}`, message, status)
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	_, _ = io.WriteString(res, body)
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
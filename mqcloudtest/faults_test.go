@@ -0,0 +1,187 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`FaultInjector`, func() {
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		delays         []time.Duration
+		mutex          sync.Mutex
+	)
+
+	// start serves an emulator with one running queue manager behind a fault injector.
+	start := func(options *mqcloudtest.FaultOptions) (*mqcloudtest.FaultInjector, *mqcloudv1.QueueManager) {
+		delays = nil
+		if options.Sleep == nil {
+			options.Sleep = func(ctx context.Context, d time.Duration) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+				delays = append(delays, d)
+				return nil
+			}
+		}
+		server := mqcloudtest.NewServer(&mqcloudtest.Options{DeployDuration: time.Nanosecond, StartDuration: time.Nanosecond})
+		server.AddServiceInstance(serviceInstanceGuid)
+		injector := mqcloudtest.NewFaultInjector(server, options)
+		testServer = httptest.NewServer(injector)
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           "http://unused.example.com",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		Expect(mqcloudService.SetServiceURL(testServer.URL)).To(Succeed())
+		instance := mqcloudService.Instance(serviceInstanceGuid)
+		task, _, err := instance.CreateQueueManager(&mqcloudv1.CreateQueueManagerOptions{
+			Name:     core.StringPtr("QM1"),
+			Location: core.StringPtr("reserved-eu-de-cluster-f884"),
+			Size:     core.StringPtr("small"),
+		})
+		Expect(err).To(BeNil())
+		return injector, instance.QueueManager(*task.QueueManagerID)
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Returns a fixed sequence of statuses that retries get past`, func() {
+		injector, queueManager := start(&mqcloudtest.FaultOptions{Rules: []mqcloudtest.FaultRule{{
+			Method:   "GET",
+			Route:    "/v1/*/queue_managers/{id}/status",
+			Statuses: []int{503, 0, 500},
+		}}})
+
+		_, response, err := queueManager.GetStatus(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+		_, response, err = queueManager.GetStatus(nil)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+
+		mqcloudService.EnableRetries(2, time.Millisecond)
+		status, _, err := queueManager.GetStatus(nil)
+		Expect(err).To(BeNil())
+		Expect(*status.Status).To(Equal("running"))
+		Expect(injector.Stats().Errors).To(Equal(2))
+
+		_, _, err = queueManager.Get(nil)
+		Expect(err).To(BeNil())
+	})
+	It(`Injects the same random faults for the same seed`, func() {
+		outcomes := func(seed int64) (statuses []int) {
+			_, queueManager := start(&mqcloudtest.FaultOptions{Seed: seed, Rules: []mqcloudtest.FaultRule{{
+				Route:         "/v1/*/queue_managers/*",
+				ErrorRate:     0.5,
+				ErrorStatuses: []int{429, 500, 503},
+				RetryAfter:    time.Second,
+				Latency:       &mqcloudtest.Latency{Min: 10 * time.Millisecond, Max: 50 * time.Millisecond},
+			}}})
+			defer testServer.Close()
+			for i := 0; i < 20; i++ {
+				_, response, _ := queueManager.Get(nil)
+				statuses = append(statuses, response.StatusCode)
+				if response.StatusCode == 429 {
+					Expect(response.Headers.Get("Retry-After")).To(Equal("1"))
+				}
+			}
+			return
+		}
+		first := outcomes(42)
+		firstDelays := delays
+		Expect(first).To(ContainElement(200))
+		Expect(first).To(ContainElement(BeNumerically(">=", 429)))
+		Expect(outcomes(42)).To(Equal(first))
+		Expect(delays).To(Equal(firstDelays))
+		for _, d := range delays {
+			Expect(d).To(BeNumerically(">=", 10*time.Millisecond))
+			Expect(d).To(BeNumerically("<", 50*time.Millisecond))
+		}
+		Expect(outcomes(7)).ToNot(Equal(first))
+	})
+	It(`Sends malformed and truncated bodies`, func() {
+		injector, queueManager := start(&mqcloudtest.FaultOptions{Rules: []mqcloudtest.FaultRule{
+			{Route: "/v1/*/queue_managers/*/connection_info", MalformedJSONRate: 1},
+			{Route: "/v1/*/queue_managers/*/available_versions", TruncateRate: 1},
+		}})
+
+		_, response, err := queueManager.GetConnectionInfo(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+
+		_, _, err = queueManager.GetAvailableUpgradeVersions(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(injector.Stats().Malformed).To(Equal(1))
+		Expect(injector.Stats().Truncated).To(Equal(1))
+	})
+	It(`Drops the connection after a partial body`, func() {
+		injector, _ := start(&mqcloudtest.FaultOptions{Rules: []mqcloudtest.FaultRule{{Route: "/v1/*/users", TruncateRate: 1}}})
+
+		res, err := http.Get(testServer.URL + "/v1/" + serviceInstanceGuid + "/users")
+		Expect(err).To(BeNil())
+		Expect(res.StatusCode).To(Equal(200))
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		Expect(errors.Is(err, io.ErrUnexpectedEOF)).To(BeTrue())
+		Expect(int64(len(body))).To(BeNumerically("<", res.ContentLength))
+
+		// Without a connection to take over, the body is still shorter than its Content-Length.
+		recorder := httptest.NewRecorder()
+		injector.ServeHTTP(recorder, httptest.NewRequest("GET", "/v1/"+serviceInstanceGuid+"/users", nil))
+		Expect(recorder.Code).To(Equal(200))
+		Expect(recorder.Header().Get("Content-Length")).ToNot(Equal(strconv.Itoa(recorder.Body.Len())))
+		Expect(injector.Stats().Truncated).To(Equal(2))
+	})
+	It(`Holds requests until the client times out`, func() {
+		_, queueManager := start(&mqcloudtest.FaultOptions{
+			Rules: []mqcloudtest.FaultRule{{Method: "GET", Route: "/v1/**", TimeoutRate: 1}},
+			Sleep: func(ctx context.Context, d time.Duration) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, _, err := queueManager.GetStatusWithContext(ctx, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+
+		res, err := http.Post(testServer.URL+"/v1/"+serviceInstanceGuid+"/users", "application/json", nil)
+		Expect(err).To(BeNil())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(400))
+	})
+})
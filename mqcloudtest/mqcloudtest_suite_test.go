@@ -0,0 +1,48 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMqcloudtest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mqcloudtest Suite")
+}
+
+const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+
+// startServer starts an emulator with one service instance and returns a client for it.
+func startServer(options *mqcloudtest.Options) (*mqcloudtest.Server, *httptest.Server, *mqcloudv1.MqcloudV1) {
+	server := mqcloudtest.NewServer(options)
+	server.AddServiceInstance(serviceInstanceGuid)
+	testServer := httptest.NewServer(server)
+	mqcloudService, err := mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+		URL:           testServer.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	Expect(err).To(BeNil())
+	return server, testServer, mqcloudService
+}
@@ -0,0 +1,406 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/go-openapi/strfmt"
+)
+
+// sizeUnits is the VPC usage of each queue manager size.
+var sizeUnits = map[string]float32{
+	mqcloudv1.ConfigurationOptions_Sizes_Xsmall: 0.25,
+	mqcloudv1.ConfigurationOptions_Sizes_Small:  0.5,
+	mqcloudv1.ConfigurationOptions_Sizes_Medium: 1,
+	mqcloudv1.ConfigurationOptions_Sizes_Large:  2,
+}
+
+var queueManagerNamePattern = regexp.MustCompile(`^[A-Za-z0-9._]{1,48}$`)
+
+// queueManager is the state of one emulated queue manager.
+type queueManager struct {
+	id          string
+	name        string
+	displayName string
+	location    string
+	size        string
+	version     string
+	created     time.Time
+	status      string
+	transitions []transition
+	gone        bool
+	trustStore  []*certificate
+	keyStore    []*certificate
+}
+
+// transition is a status change that happens when the clock reaches a given time.
+type transition struct {
+	at      time.Time
+	status  string
+	version string
+	remove  bool
+}
+
+// advance applies the transitions that are due.
+func (qm *queueManager) advance(now time.Time) {
+	for len(qm.transitions) > 0 && !qm.transitions[0].at.After(now) {
+		next := qm.transitions[0]
+		qm.transitions = qm.transitions[1:]
+		if next.remove {
+			qm.gone = true
+			return
+		}
+		qm.status = next.status
+		if next.version != "" {
+			qm.version = next.version
+		}
+	}
+}
+
+// busy reports whether the queue manager is in a status that rejects changes.
+func (qm *queueManager) busy() bool {
+	switch qm.status {
+	case mqcloudv1.QueueManagerStatus_Status_Deploying,
+		mqcloudv1.QueueManagerStatus_Status_Starting,
+		mqcloudv1.QueueManagerStatus_Status_UpdatingRevision,
+		mqcloudv1.QueueManagerStatus_Status_UpgradingVersion,
+		mqcloudv1.QueueManagerStatus_Status_Deleting:
+		return true
+	}
+	return false
+}
+
+// host returns the hostname clients use to connect to the queue manager.
+func (qm *queueManager) host() string {
+	name := strings.ToLower(strings.NewReplacer(".", "", "_", "").Replace(qm.name))
+	return fmt.Sprintf("%s-%s.qm.%s.mq.example.com", name, qm.id[:8], qm.location)
+}
+
+// port returns the port clients use to connect to the queue manager.
+func (qm *queueManager) port() int64 {
+	n, _ := strconv.ParseInt(qm.id[:4], 16, 64)
+	return 30000 + n%2000
+}
+
+// settle applies the status transitions that are due for every queue manager in the instance and
+// drops the ones whose deletion has finished.
+func (server *Server) settle(instance *serviceInstance) {
+	now := server.options.Clock.Now()
+	remaining := instance.queueManagers[:0]
+	for _, qm := range instance.queueManagers {
+		qm.advance(now)
+		if !qm.gone {
+			remaining = append(remaining, qm)
+		}
+	}
+	for i := len(remaining); i < len(instance.queueManagers); i++ {
+		instance.queueManagers[i] = nil
+	}
+	instance.queueManagers = remaining
+}
+
+// SetQueueManagerStatus puts a queue manager into the given status, cancelling any pending
+// transitions. It lets tests reach statuses the emulator does not produce by itself, such as
+// updating_revision or stopped.
+func (server *Server) SetQueueManagerStatus(serviceInstanceGuid string, queueManagerID string, status string) error {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	instance, ok := server.instances[serviceInstanceGuid]
+	if !ok {
+		return fmt.Errorf("service instance %s not found", serviceInstanceGuid)
+	}
+	server.settle(instance)
+	qm := instance.queueManager(queueManagerID)
+	if qm == nil {
+		return fmt.Errorf("queue manager %s not found", queueManagerID)
+	}
+	qm.status = status
+	qm.transitions = nil
+	return nil
+}
+
+func (instance *serviceInstance) queueManager(id string) *queueManager {
+	for _, qm := range instance.queueManagers {
+		if qm.id == id {
+			return qm
+		}
+	}
+	return nil
+}
+
+func (server *Server) routeQueueManagers(request *apiRequest, route []string) {
+	if len(route) == 0 {
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET":  server.listQueueManagers,
+			"POST": server.createQueueManager,
+		})
+		return
+	}
+	qm := request.instance.queueManager(route[0])
+	if qm == nil {
+		server.writeError(request.res, http.StatusNotFound, "queue_manager_not_found", fmt.Sprintf("queue manager %s not found", route[0]))
+		return
+	}
+	handle := func(handler func(*apiRequest, *queueManager)) func(*apiRequest) {
+		return func(request *apiRequest) {
+			handler(request, qm)
+		}
+	}
+	switch {
+	case len(route) == 1:
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET":    handle(server.getQueueManager),
+			"DELETE": handle(server.deleteQueueManager),
+		})
+	case len(route) == 2 && route[1] == "version":
+		server.route(request, true, map[string]func(*apiRequest){"PUT": handle(server.setQueueManagerVersion)})
+	case len(route) == 2 && route[1] == "available_versions":
+		server.route(request, true, map[string]func(*apiRequest){"GET": handle(server.getAvailableVersions)})
+	case len(route) == 2 && route[1] == "connection_info":
+		server.route(request, true, map[string]func(*apiRequest){"GET": handle(server.getConnectionInfo)})
+	case len(route) == 2 && route[1] == "status":
+		server.route(request, true, map[string]func(*apiRequest){"GET": handle(server.getQueueManagerStatus)})
+	case len(route) >= 3 && route[1] == "certificates":
+		server.routeCertificates(request, qm, route[2:])
+	default:
+		server.route(request, false, nil)
+	}
+}
+
+func (server *Server) listQueueManagers(request *apiRequest) {
+	queueManagers := request.instance.queueManagers
+	offset, limit, links, ok := server.page(request, "queue_managers", len(queueManagers))
+	if !ok {
+		return
+	}
+	start, end := pageBounds(offset, limit, len(queueManagers))
+	collection := &mqcloudv1.QueueManagerDetailsCollection{
+		Offset:        core.Int64Ptr(int64(offset)),
+		Limit:         core.Int64Ptr(int64(limit)),
+		First:         links.first,
+		Next:          links.next,
+		Previous:      links.previous,
+		QueueManagers: []mqcloudv1.QueueManagerDetails{},
+	}
+	for _, qm := range queueManagers[start:end] {
+		collection.QueueManagers = append(collection.QueueManagers, *server.queueManagerDetails(request, qm))
+	}
+	writeJSON(request.res, http.StatusOK, collection)
+}
+
+func (server *Server) createQueueManager(request *apiRequest) {
+	var body struct {
+		Name        string `json:"name"`
+		Location    string `json:"location"`
+		Size        string `json:"size"`
+		DisplayName string `json:"display_name"`
+		Version     string `json:"version"`
+	}
+	if !server.decodeBody(request, &body) {
+		return
+	}
+	if !queueManagerNamePattern.MatchString(body.Name) {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_name", fmt.Sprintf("queue manager name %q is not valid", body.Name))
+		return
+	}
+	if !contains(server.options.Locations, body.Location) {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_location", fmt.Sprintf("location %q is not available", body.Location))
+		return
+	}
+	if !contains(server.options.Sizes, body.Size) {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_size", fmt.Sprintf("size %q is not available", body.Size))
+		return
+	}
+	if body.Version == "" {
+		body.Version = server.latestVersion()
+	} else if !contains(server.options.Versions, body.Version) {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_version", fmt.Sprintf("version %q is not available", body.Version))
+		return
+	}
+	for _, existing := range request.instance.queueManagers {
+		if existing.name == body.Name {
+			server.writeError(request.res, http.StatusConflict, "queue_manager_name_exists", fmt.Sprintf("a queue manager named %s already exists", body.Name))
+			return
+		}
+	}
+	if body.DisplayName == "" {
+		body.DisplayName = body.Name
+	}
+
+	now := server.options.Clock.Now()
+	started := now.Add(server.options.DeployDuration)
+	qm := &queueManager{
+		id:          server.newID(),
+		name:        body.Name,
+		displayName: body.DisplayName,
+		location:    body.Location,
+		size:        body.Size,
+		version:     body.Version,
+		created:     now,
+		status:      mqcloudv1.QueueManagerStatus_Status_Deploying,
+		transitions: []transition{
+			{at: started, status: mqcloudv1.QueueManagerStatus_Status_Starting},
+			{at: started.Add(server.options.StartDuration), status: mqcloudv1.QueueManagerStatus_Status_Running},
+		},
+	}
+	request.instance.queueManagers = append(request.instance.queueManagers, qm)
+	writeJSON(request.res, http.StatusAccepted, server.taskStatus(request, qm))
+}
+
+func (server *Server) getQueueManager(request *apiRequest, qm *queueManager) {
+	writeJSON(request.res, http.StatusOK, server.queueManagerDetails(request, qm))
+}
+
+func (server *Server) deleteQueueManager(request *apiRequest, qm *queueManager) {
+	if !server.checkNotBusy(request, qm) {
+		return
+	}
+	qm.status = mqcloudv1.QueueManagerStatus_Status_Deleting
+	qm.transitions = []transition{{at: server.options.Clock.Now().Add(server.options.DeleteDuration), remove: true}}
+	writeJSON(request.res, http.StatusAccepted, server.taskStatus(request, qm))
+}
+
+func (server *Server) setQueueManagerVersion(request *apiRequest, qm *queueManager) {
+	var body struct {
+		Version string `json:"version"`
+	}
+	if !server.decodeBody(request, &body) {
+		return
+	}
+	if !contains(server.upgradeVersions(qm.version), body.Version) {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_version", fmt.Sprintf("queue manager %s cannot be upgraded from %s to %q", qm.name, qm.version, body.Version))
+		return
+	}
+	if !server.checkNotBusy(request, qm) {
+		return
+	}
+	if qm.status != mqcloudv1.QueueManagerStatus_Status_Running {
+		server.writeError(request.res, http.StatusConflict, "queue_manager_not_running", fmt.Sprintf("queue manager %s is %s", qm.name, qm.status))
+		return
+	}
+	qm.status = mqcloudv1.QueueManagerStatus_Status_UpgradingVersion
+	qm.transitions = []transition{{
+		at:      server.options.Clock.Now().Add(server.options.UpgradeDuration),
+		status:  mqcloudv1.QueueManagerStatus_Status_Running,
+		version: body.Version,
+	}}
+	writeJSON(request.res, http.StatusAccepted, server.taskStatus(request, qm))
+}
+
+func (server *Server) getAvailableVersions(request *apiRequest, qm *queueManager) {
+	versions := server.upgradeVersions(qm.version)
+	upgrades := &mqcloudv1.QueueManagerVersionUpgrades{
+		TotalCount: core.Int64Ptr(int64(len(versions))),
+		Versions:   []mqcloudv1.QueueManagerVersionUpgrade{},
+	}
+	targetDate := strfmt.DateTime(server.options.Clock.Now().UTC().Add(30 * 24 * time.Hour).Truncate(24 * time.Hour))
+	for _, version := range versions {
+		upgrades.Versions = append(upgrades.Versions, mqcloudv1.QueueManagerVersionUpgrade{
+			Version:    core.StringPtr(version),
+			TargetDate: &targetDate,
+		})
+	}
+	writeJSON(request.res, http.StatusOK, upgrades)
+}
+
+func (server *Server) getConnectionInfo(request *apiRequest, qm *queueManager) {
+	channel := func(name string) mqcloudv1.ConnectionInfoChannel {
+		return mqcloudv1.ConnectionInfoChannel{
+			Name: core.StringPtr(name),
+			ClientConnection: &mqcloudv1.ClientConnection{
+				Connection:   []mqcloudv1.ConnectionDetails{{Host: core.StringPtr(qm.host()), Port: core.Int64Ptr(qm.port())}},
+				QueueManager: core.StringPtr(qm.name),
+			},
+			TransmissionSecurity: &mqcloudv1.TransmissionSecurity{CipherSpecification: core.StringPtr("ANY_TLS12_OR_HIGHER")},
+			Type:                 core.StringPtr("clientConnection"),
+		}
+	}
+	writeJSON(request.res, http.StatusOK, &mqcloudv1.ConnectionInfo{
+		Channel: []mqcloudv1.ConnectionInfoChannel{channel("CLOUD.APP.SVRCONN"), channel("CLOUD.ADMIN.SVRCONN")},
+	})
+}
+
+func (server *Server) getQueueManagerStatus(request *apiRequest, qm *queueManager) {
+	writeJSON(request.res, http.StatusOK, &mqcloudv1.QueueManagerStatus{Status: core.StringPtr(qm.status)})
+}
+
+// checkNotBusy writes a 409 response if the queue manager is in a transitional status.
+func (server *Server) checkNotBusy(request *apiRequest, qm *queueManager) bool {
+	if qm.busy() {
+		server.writeError(request.res, http.StatusConflict, "queue_manager_busy", fmt.Sprintf("queue manager %s is %s", qm.name, qm.status))
+		return false
+	}
+	return true
+}
+
+// upgradeVersions returns the versions newer than the given one.
+func (server *Server) upgradeVersions(version string) []string {
+	for i, v := range server.options.Versions {
+		if v == version {
+			return server.options.Versions[i+1:]
+		}
+	}
+	return nil
+}
+
+func (server *Server) queueManagerDetails(request *apiRequest, qm *queueManager) *mqcloudv1.QueueManagerDetails {
+	href := request.base + "/queue_managers/" + qm.id
+	created := strfmt.DateTime(qm.created.UTC())
+	return &mqcloudv1.QueueManagerDetails{
+		ID:                          core.StringPtr(qm.id),
+		Name:                        core.StringPtr(qm.name),
+		DisplayName:                 core.StringPtr(qm.displayName),
+		Location:                    core.StringPtr(qm.location),
+		Size:                        core.StringPtr(qm.size),
+		StatusURI:                   core.StringPtr(href + "/status"),
+		Version:                     core.StringPtr(qm.version),
+		WebConsoleURL:               core.StringPtr("https://web-" + qm.host() + "/ibmmq/console"),
+		RestApiEndpointURL:          core.StringPtr("https://web-" + qm.host() + "/ibmmq/rest/v3/messaging"),
+		AdministratorApiEndpointURL: core.StringPtr("https://web-" + qm.host() + "/ibmmq/rest/v3/admin"),
+		ConnectionInfoURI:           core.StringPtr(href + "/connection_info"),
+		DateCreated:                 &created,
+		UpgradeAvailable:            core.BoolPtr(len(server.upgradeVersions(qm.version)) > 0),
+		AvailableUpgradeVersionsURI: core.StringPtr(href + "/available_versions"),
+		Href:                        core.StringPtr(href),
+	}
+}
+
+func (server *Server) taskStatus(request *apiRequest, qm *queueManager) *mqcloudv1.QueueManagerTaskStatus {
+	href := request.base + "/queue_managers/" + qm.id
+	return &mqcloudv1.QueueManagerTaskStatus{
+		QueueManagerURI:       core.StringPtr(href),
+		QueueManagerStatusURI: core.StringPtr(href + "/status"),
+		QueueManagerID:        core.StringPtr(qm.id),
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest_test
+
+import (
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Queue managers`, func() {
+	var (
+		server         *mqcloudtest.Server
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		clock          *mqcloudtest.ManualClock
+		queueManager   *mqcloudv1.QueueManager
+	)
+
+	status := func() string {
+		result, _, err := queueManager.GetStatus(nil)
+		Expect(err).To(BeNil())
+		return *result.Status
+	}
+
+	BeforeEach(func() {
+		clock = mqcloudtest.NewManualClock(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+		server, testServer, mqcloudService = startServer(&mqcloudtest.Options{
+			Clock:    clock,
+			Versions: []string{"9.3.4_2", "9.3.5_1"},
+		})
+		instance := mqcloudService.Instance(serviceInstanceGuid)
+		task, response, err := instance.CreateQueueManager(&mqcloudv1.CreateQueueManagerOptions{
+			Name:     core.StringPtr("QM_ONE"),
+			Location: core.StringPtr("reserved-eu-de-cluster-f884"),
+			Size:     core.StringPtr(mqcloudv1.CreateQueueManagerOptions_Size_Xsmall),
+			Version:  core.StringPtr("9.3.4_2"),
+		})
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		queueManager = instance.QueueManager(*task.QueueManagerID)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Moves through deploying and starting to running as the clock advances`, func() {
+		Expect(status()).To(Equal(mqcloudv1.QueueManagerStatus_Status_Deploying))
+		clock.Advance(mqcloudtest.DefaultDeployDuration)
+		Expect(status()).To(Equal(mqcloudv1.QueueManagerStatus_Status_Starting))
+		clock.Advance(mqcloudtest.DefaultStartDuration)
+		Expect(status()).To(Equal(mqcloudv1.QueueManagerStatus_Status_Running))
+
+		details, _, err := queueManager.Get(nil)
+		Expect(err).To(BeNil())
+		Expect(*details.Name).To(Equal("QM_ONE"))
+		Expect(*details.DisplayName).To(Equal("QM_ONE"))
+		Expect(*details.UpgradeAvailable).To(BeTrue())
+		Expect(*details.Href).To(HaveSuffix("/queue_managers/" + queueManager.ID()))
+
+		info, _, err := queueManager.GetConnectionInfo(nil)
+		Expect(err).To(BeNil())
+		Expect(info.Hosts()).To(HaveLen(1))
+		Expect(info.Hosts()[0]).To(HavePrefix("qmone-"))
+	})
+	It(`Rejects duplicate names and changes while deploying`, func() {
+		_, response, err := mqcloudService.CreateQueueManager(mqcloudService.NewCreateQueueManagerOptions(serviceInstanceGuid, "QM_ONE", "reserved-eu-de-cluster-f884", "small"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		_, response, err = queueManager.SetVersion(&mqcloudv1.SetQueueManagerVersionOptions{Version: core.StringPtr("9.3.5_1")})
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+		_, response, err = queueManager.Delete(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+	})
+	It(`Upgrades the version`, func() {
+		clock.Advance(time.Hour)
+		upgrades, _, err := queueManager.GetAvailableUpgradeVersions(nil)
+		Expect(err).To(BeNil())
+		Expect(*upgrades.TotalCount).To(BeEquivalentTo(1))
+		Expect(*upgrades.Versions[0].Version).To(Equal("9.3.5_1"))
+
+		_, response, err := queueManager.SetVersion(&mqcloudv1.SetQueueManagerVersionOptions{Version: core.StringPtr("9.3.5_1")})
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(status()).To(Equal(mqcloudv1.QueueManagerStatus_Status_UpgradingVersion))
+
+		clock.Advance(mqcloudtest.DefaultUpgradeDuration)
+		details, _, err := queueManager.Get(nil)
+		Expect(err).To(BeNil())
+		Expect(*details.Version).To(Equal("9.3.5_1"))
+		Expect(*details.UpgradeAvailable).To(BeFalse())
+		Expect(status()).To(Equal(mqcloudv1.QueueManagerStatus_Status_Running))
+	})
+	It(`Deletes queue managers after the deleting status`, func() {
+		clock.Advance(time.Hour)
+		_, response, err := queueManager.Delete(nil)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(status()).To(Equal(mqcloudv1.QueueManagerStatus_Status_Deleting))
+
+		clock.Advance(mqcloudtest.DefaultDeleteDuration)
+		_, response, err = queueManager.GetStatus(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+		list, _, err := mqcloudService.ListQueueManagers(mqcloudService.NewListQueueManagersOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		Expect(list.QueueManagers).To(BeEmpty())
+	})
+	It(`Lets tests force a status`, func() {
+		Expect(server.SetQueueManagerStatus(serviceInstanceGuid, queueManager.ID(), mqcloudv1.QueueManagerStatus_Status_UpdatingRevision)).To(Succeed())
+		clock.Advance(time.Hour)
+		Expect(status()).To(Equal(mqcloudv1.QueueManagerStatus_Status_UpdatingRevision))
+		Expect(server.SetQueueManagerStatus(serviceInstanceGuid, "missing", "running")).ToNot(Succeed())
+	})
+})
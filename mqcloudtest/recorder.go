@@ -0,0 +1,431 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Recorder modes.
+const (
+	// ModeRecord sends requests to the real service and records them.
+	ModeRecord = "record"
+
+	// ModeReplay answers requests from a cassette without using the network.
+	ModeReplay = "replay"
+)
+
+// Scrubbed replaces secret values in cassettes.
+const Scrubbed = "SCRUBBED"
+
+// placeholderPrefix starts the placeholders that replace GUIDs in cassettes.
+const placeholderPrefix = "00000000-0000-4000-8000-"
+
+var (
+	reGUID        = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	reSecretField = regexp.MustCompile(`("(?:api_?key|access_token|refresh_token|password)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	reBearer      = regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9\-_.~+/]+=*`)
+	rePrivateKey  = regexp.MustCompile(`(-----BEGIN [A-Z ]*PRIVATE KEY-----)[\s\S]*?(-----END [A-Z ]*PRIVATE KEY-----)`)
+)
+
+// recordedHeaders are the headers kept in cassettes. Others, including Authorization, are dropped.
+var recordedHeaders = []string{"Accept", "Content-Type", "Retry-After", "X-Request-Id"}
+
+// Cassette : Recorded interactions with the service, replayed in order.
+type Cassette struct {
+	// Test configuration saved with the recording, such as the IDs of resources it used.
+	Config map[string]string `json:"config,omitempty"`
+
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction : One recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest : A scrubbed request.
+type RecordedRequest struct {
+	Method       string            `json:"method"`
+	URL          string            `json:"url"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Body         string            `json:"body,omitempty"`
+	BodyEncoding string            `json:"body_encoding,omitempty"`
+}
+
+// RecordedResponse : A scrubbed response.
+type RecordedResponse struct {
+	StatusCode   int               `json:"status_code"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	Body         string            `json:"body,omitempty"`
+	BodyEncoding string            `json:"body_encoding,omitempty"`
+}
+
+// RecorderOptions : Settings for NewRecorder.
+type RecorderOptions struct {
+	// ModeRecord or ModeReplay.
+	Mode string
+
+	// The cassette file. It is read in replay mode and written by Save in record mode.
+	Path string
+
+	// The transport used to reach the service in record mode. Defaults to the transport of the
+	// client the recorder is installed in, or http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Values, such as API keys, that are replaced with Scrubbed wherever they appear.
+	Secrets []string
+}
+
+// Recorder : An http.RoundTripper that records interactions with the service to a cassette, or replays
+// them. Recordings keep no Authorization headers, API keys, tokens or Secrets, and replace GUIDs with
+// placeholders numbered in order of first use. In replay mode every request must match the next
+// recorded one, after the same scrubbing, or the round trip fails. Private keys in uploaded PEM files
+// are scrubbed too, but the rest of an upload must match, so replays need the same certificate files.
+type Recorder struct {
+	mode      string
+	path      string
+	transport http.RoundTripper
+	secrets   []string
+
+	mutex        sync.Mutex
+	cassette     Cassette
+	next         int
+	guids        map[string]string
+	placeholders map[string]bool
+}
+
+// NewRecorder returns a recorder. In replay mode the cassette must exist.
+func NewRecorder(options *RecorderOptions) (recorder *Recorder, err error) {
+	if options == nil || (options.Mode != ModeRecord && options.Mode != ModeReplay) {
+		return nil, fmt.Errorf("recorder mode must be %q or %q", ModeRecord, ModeReplay)
+	}
+	recorder = &Recorder{
+		mode:         options.Mode,
+		path:         options.Path,
+		transport:    options.Transport,
+		guids:        map[string]string{},
+		placeholders: map[string]bool{},
+	}
+	for _, secret := range options.Secrets {
+		if secret != "" {
+			recorder.secrets = append(recorder.secrets, secret)
+		}
+	}
+	if recorder.mode == ModeReplay {
+		contents, err := os.ReadFile(options.Path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(contents, &recorder.cassette); err != nil {
+			return nil, fmt.Errorf("cassette %s is not valid: %w", options.Path, err)
+		}
+		recorder.seedPlaceholders(string(contents))
+	}
+	return recorder, nil
+}
+
+// seedPlaceholders marks the placeholders in a recorded cassette as already scrubbed, so that replayed
+// requests that use them, such as IDs from the cassette's config or from replayed responses, keep
+// them as they are rather than having them numbered again in the order they are first used. GUIDs
+// that are new in the replay are numbered after them.
+func (recorder *Recorder) seedPlaceholders(contents string) {
+	for _, guid := range reGUID.FindAllString(contents, -1) {
+		guid = strings.ToLower(guid)
+		if strings.HasPrefix(guid, placeholderPrefix) && !recorder.placeholders[guid] {
+			recorder.guids[guid] = guid
+			recorder.placeholders[guid] = true
+		}
+	}
+}
+
+// Mode returns the recorder's mode.
+func (recorder *Recorder) Mode() string {
+	return recorder.mode
+}
+
+// Install makes a service send its requests through the recorder. It can be called before or after
+// EnableRetries. When the service already retries requests, the recorder wraps the transport of the
+// HTTP client that the retrying client sends each attempt with, leaving the retrying client and the
+// retry policy installed on it in place; when retries are enabled later, the retrying client wraps
+// the recorder in the same way. Either way every attempt, including each retry, is recorded.
+func (recorder *Recorder) Install(service *core.BaseService) {
+	if service.Client == nil {
+		service.Client = core.DefaultHTTPClient()
+	}
+	retrying, isRetrying := service.Client.Transport.(*retryablehttp.RoundTripper)
+	inner := service.Client
+	if isRetrying {
+		inner = retrying.Client.HTTPClient
+	}
+
+	client := *inner
+	if recorder.transport == nil {
+		recorder.transport = client.Transport
+	}
+	client.Transport = recorder
+	if isRetrying {
+		retrying.Client.HTTPClient = &client
+	} else {
+		service.Client = &client
+	}
+}
+
+// SetConfig saves test configuration in the cassette, scrubbing it like requests. Entries whose names
+// suggest credentials are left out.
+func (recorder *Recorder) SetConfig(config map[string]string) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.cassette.Config = map[string]string{}
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		upper := strings.ToUpper(key)
+		if strings.Contains(upper, "APIKEY") || strings.Contains(upper, "API_KEY") || strings.Contains(upper, "TOKEN") ||
+			strings.Contains(upper, "PASSWORD") || strings.Contains(upper, "SECRET") || strings.Contains(upper, "AUTH") {
+			continue
+		}
+		recorder.cassette.Config[key] = recorder.scrub(config[key])
+	}
+}
+
+// Config returns the test configuration saved in the cassette.
+func (recorder *Recorder) Config() map[string]string {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	config := map[string]string{}
+	for key, value := range recorder.cassette.Config {
+		config[key] = value
+	}
+	return config
+}
+
+// Remaining returns the number of recorded interactions not yet replayed.
+func (recorder *Recorder) Remaining() int {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return len(recorder.cassette.Interactions) - recorder.next
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing in replay mode.
+func (recorder *Recorder) Save() error {
+	if recorder.mode != ModeRecord {
+		return nil
+	}
+	recorder.mutex.Lock()
+	contents, err := json.MarshalIndent(&recorder.cassette, "", "  ")
+	recorder.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(recorder.path), 0o755); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(filepath.Dir(recorder.path), ".cassette-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	if _, err = temp.Write(append(contents, '\n')); err != nil {
+		temp.Close()
+		return err
+	}
+	if err = temp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(temp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), recorder.path)
+}
+
+// RoundTrip records or replays one request.
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	recorder.mutex.Lock()
+	recorded := recorder.recordRequest(req, body)
+	recorder.mutex.Unlock()
+
+	if recorder.mode == ModeReplay {
+		return recorder.replay(req, recorded)
+	}
+
+	transport := recorder.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	response := RecordedResponse{StatusCode: res.StatusCode, Headers: recorder.headers(res.Header)}
+	response.Body, response.BodyEncoding = recorder.encodeBody(responseBody)
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, Interaction{Request: recorded, Response: response})
+	return res, nil
+}
+
+// replay returns the response recorded for the next interaction, if the request matches it.
+func (recorder *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	if recorder.next >= len(recorder.cassette.Interactions) {
+		return nil, fmt.Errorf("cassette %s has no interaction for %s %s", recorder.path, recorded.Method, recorded.URL)
+	}
+	interaction := recorder.cassette.Interactions[recorder.next]
+	if err := matchRequest(interaction.Request, recorded); err != nil {
+		return nil, fmt.Errorf("request %d does not match cassette %s: %w", recorder.next+1, recorder.path, err)
+	}
+	recorder.next++
+
+	body := []byte(interaction.Response.Body)
+	if interaction.Response.BodyEncoding == "base64" {
+		var err error
+		if body, err = base64.StdEncoding.DecodeString(interaction.Response.Body); err != nil {
+			return nil, err
+		}
+	}
+	header := http.Header{}
+	for name, value := range interaction.Response.Headers {
+		header.Set(name, value)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// matchRequest checks that a request matches a recorded one exactly.
+func matchRequest(expected RecordedRequest, actual RecordedRequest) error {
+	if expected.Method != actual.Method || expected.URL != actual.URL {
+		return fmt.Errorf("expected %s %s, got %s %s", expected.Method, expected.URL, actual.Method, actual.URL)
+	}
+	if expected.Body != actual.Body || expected.BodyEncoding != actual.BodyEncoding {
+		return errors.New("the request body differs from the recorded one")
+	}
+	for _, name := range recordedHeaders {
+		if expected.Headers[name] != actual.Headers[name] {
+			return fmt.Errorf("expected %s header %q, got %q", name, expected.Headers[name], actual.Headers[name])
+		}
+	}
+	return nil
+}
+
+// recordRequest returns the scrubbed form of a request.
+func (recorder *Recorder) recordRequest(req *http.Request, body []byte) RecordedRequest {
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		URL:     recorder.scrub(req.URL.String()),
+		Headers: recorder.headers(req.Header),
+	}
+	// Multipart boundaries are random, so they are replaced with a fixed one.
+	if mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type")); err == nil && strings.HasPrefix(mediaType, "multipart/") && params["boundary"] != "" {
+		body = bytes.ReplaceAll(body, []byte(params["boundary"]), []byte("BOUNDARY"))
+		recorded.Headers["Content-Type"] = strings.ReplaceAll(recorded.Headers["Content-Type"], params["boundary"], "BOUNDARY")
+	}
+	recorded.Body, recorded.BodyEncoding = recorder.encodeBody(body)
+	return recorded
+}
+
+// headers returns the scrubbed headers that are kept in cassettes.
+func (recorder *Recorder) headers(header http.Header) map[string]string {
+	kept := map[string]string{}
+	for _, name := range recordedHeaders {
+		if value := header.Get(name); value != "" {
+			kept[name] = recorder.scrub(value)
+		}
+	}
+	return kept
+}
+
+// encodeBody scrubs a body, base64 encoding it if it is not text.
+func (recorder *Recorder) encodeBody(body []byte) (string, string) {
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), "base64"
+	}
+	return recorder.scrub(string(body)), ""
+}
+
+// scrub removes secrets from a value and replaces GUIDs with placeholders. A GUID gets the same
+// placeholder wherever it appears, so recorded requests and responses still refer to each other.
+func (recorder *Recorder) scrub(value string) string {
+	for _, secret := range recorder.secrets {
+		value = strings.ReplaceAll(value, secret, Scrubbed)
+	}
+	value = reSecretField.ReplaceAllString(value, `${1}"`+Scrubbed+`"`)
+	value = reBearer.ReplaceAllString(value, "Bearer "+Scrubbed)
+	value = rePrivateKey.ReplaceAllString(value, "${1}\n"+Scrubbed+"\n${2}")
+	return reGUID.ReplaceAllStringFunc(value, func(guid string) string {
+		guid = strings.ToLower(guid)
+		if recorder.placeholders[guid] {
+			return guid
+		}
+		placeholder, ok := recorder.guids[guid]
+		if !ok {
+			placeholder = fmt.Sprintf("%s%012d", placeholderPrefix, len(recorder.guids)+1)
+			recorder.guids[guid] = placeholder
+			recorder.placeholders[placeholder] = true
+		}
+		return placeholder
+	})
+}
@@ -0,0 +1,237 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/hashicorp/go-retryablehttp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Recorder`, func() {
+	const token = "eyJhbGciOiJSUzI1NiJ9.secret-token"
+	var (
+		dir      string
+		cassette string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "recorder")
+		Expect(err).To(BeNil())
+		cassette = filepath.Join(dir, "cassettes", "users.json")
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// record runs a workflow against the emulator and saves it, returning the raw API key.
+	record := func() string {
+		_, testServer, mqcloudService := startServer(nil)
+		defer testServer.Close()
+		mqcloudService.Service.Options.Authenticator = &core.BearerTokenAuthenticator{BearerToken: token}
+
+		recorder, err := mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{Mode: mqcloudtest.ModeRecord, Path: cassette})
+		Expect(err).To(BeNil())
+		recorder.Install(mqcloudService.Service)
+		recorder.SetConfig(map[string]string{
+			"URL":                   testServer.URL,
+			"SERVICE_INSTANCE_GUID": serviceInstanceGuid,
+			"APIKEY":                "config-key",
+		})
+
+		_, _, err = mqcloudService.CreateUser(mqcloudService.NewCreateUserOptions(serviceInstanceGuid, "alice@example.com", "alice"))
+		Expect(err).To(BeNil())
+		created, _, err := mqcloudService.CreateApplication(mqcloudService.NewCreateApplicationOptions(serviceInstanceGuid, "orders"))
+		Expect(err).To(BeNil())
+		_, _, err = mqcloudService.GetApplication(mqcloudService.NewGetApplicationOptions(serviceInstanceGuid, *created.ID))
+		Expect(err).To(BeNil())
+		_, response, err := mqcloudService.GetUser(mqcloudService.NewGetUserOptions(serviceInstanceGuid, "missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+
+		Expect(recorder.Save()).To(Succeed())
+		return created.ApiKey.Reveal()
+	}
+
+	// replay returns a client that replays the cassette, and the recorder.
+	replay := func() (*mqcloudv1.MqcloudV1, *mqcloudtest.Recorder, map[string]string) {
+		recorder, err := mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{Mode: mqcloudtest.ModeReplay, Path: cassette})
+		Expect(err).To(BeNil())
+		config := recorder.Config()
+		mqcloudService, err := mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           config["URL"],
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		recorder.Install(mqcloudService.Service)
+		return mqcloudService, recorder, config
+	}
+
+	It(`Scrubs tokens, API keys and GUIDs from recordings`, func() {
+		apiKey := record()
+		contents, err := os.ReadFile(cassette)
+		Expect(err).To(BeNil())
+		Expect(string(contents)).ToNot(ContainSubstring(token))
+		Expect(string(contents)).ToNot(ContainSubstring(apiKey))
+		Expect(string(contents)).ToNot(ContainSubstring("config-key"))
+		Expect(string(contents)).ToNot(ContainSubstring(serviceInstanceGuid))
+		Expect(string(contents)).To(ContainSubstring(mqcloudtest.Scrubbed))
+		info, err := os.Stat(cassette)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o644)))
+	})
+	It(`Records every attempt of retried requests`, func() {
+		for _, installFirst := range []bool{false, true} {
+			emulator := mqcloudtest.NewServer(nil)
+			emulator.AddServiceInstance(serviceInstanceGuid)
+			injector := mqcloudtest.NewFaultInjector(emulator, &mqcloudtest.FaultOptions{
+				Rules: []mqcloudtest.FaultRule{{Method: "GET", Route: "/v1/*/users", Statuses: []int{503}}},
+			})
+			testServer := httptest.NewServer(injector)
+			mqcloudService, err := mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+			recorder, err := mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{Mode: mqcloudtest.ModeRecord, Path: cassette})
+			Expect(err).To(BeNil())
+			if installFirst {
+				recorder.Install(mqcloudService.Service)
+				mqcloudService.EnableRetries(2, 10*time.Millisecond)
+			} else {
+				mqcloudService.EnableRetries(2, 10*time.Millisecond)
+				recorder.Install(mqcloudService.Service)
+			}
+			Expect(mqcloudService.Service.Client.Transport).To(BeAssignableToTypeOf(&retryablehttp.RoundTripper{}))
+
+			_, response, err := mqcloudService.ListUsers(mqcloudService.NewListUsersOptions(serviceInstanceGuid))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(injector.Stats().Requests).To(Equal(2))
+			Expect(recorder.Remaining()).To(Equal(2))
+			testServer.Close()
+		}
+	})
+	It(`Replays recordings offline`, func() {
+		record()
+		mqcloudService, recorder, config := replay()
+		Expect(config).ToNot(HaveKey("APIKEY"))
+		guid := config["SERVICE_INSTANCE_GUID"]
+		Expect(guid).ToNot(Equal(serviceInstanceGuid))
+		Expect(recorder.Remaining()).To(Equal(4))
+
+		user, _, err := mqcloudService.CreateUser(mqcloudService.NewCreateUserOptions(guid, "alice@example.com", "alice"))
+		Expect(err).To(BeNil())
+		Expect(*user.Name).To(Equal("alice"))
+		created, response, err := mqcloudService.CreateApplication(mqcloudService.NewCreateApplicationOptions(guid, "orders"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(created.ApiKey.Reveal()).To(Equal(mqcloudtest.Scrubbed))
+		Expect(*created.Href).To(ContainSubstring(guid))
+		application, _, err := mqcloudService.GetApplication(mqcloudService.NewGetApplicationOptions(guid, *created.ID))
+		Expect(err).To(BeNil())
+		Expect(*application.Name).To(Equal("orders"))
+		_, response, err = mqcloudService.GetUser(mqcloudService.NewGetUserOptions(guid, "missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+		Expect(response.Headers.Get("Content-Type")).To(HavePrefix("application/json"))
+		Expect(recorder.Remaining()).To(Equal(0))
+
+		_, _, err = mqcloudService.GetUser(mqcloudService.NewGetUserOptions(guid, "missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("no interaction"))
+	})
+	It(`Replays requests that use several config GUIDs and GUIDs from responses`, func() {
+		const queueManagerID = "5b0bbfa4-8d0f-4f0d-9a5c-2bb8e0f1b7a3"
+		const taskID = "c0d3e8f1-7e4a-4c9e-a0a5-6f3b1d2e9c87"
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-Type", "application/json")
+			if strings.HasSuffix(req.URL.Path, "/queue_managers/"+queueManagerID) {
+				fmt.Fprintf(res, `{"id":%q,"task_id":%q}`, queueManagerID, taskID)
+				return
+			}
+			fmt.Fprintf(res, `{"id":%q,"status":"success"}`, strings.TrimPrefix(req.URL.Path, "/v1/"+serviceInstanceGuid+"/tasks/"))
+		}))
+		defer testServer.Close()
+
+		// get requests the queue manager, then the task its response names.
+		get := func(client *http.Client, url string, guid string, id string) {
+			res, err := client.Get(url + "/v1/" + guid + "/queue_managers/" + id)
+			Expect(err).To(BeNil())
+			var queueManager map[string]string
+			Expect(json.NewDecoder(res.Body).Decode(&queueManager)).To(Succeed())
+			res.Body.Close()
+			res, err = client.Get(url + "/v1/" + guid + "/tasks/" + queueManager["task_id"])
+			Expect(err).To(BeNil())
+			res.Body.Close()
+			Expect(res.StatusCode).To(Equal(200))
+		}
+
+		recorder, err := mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{Mode: mqcloudtest.ModeRecord, Path: cassette})
+		Expect(err).To(BeNil())
+		recorder.SetConfig(map[string]string{
+			"URL":                   testServer.URL,
+			"SERVICE_INSTANCE_GUID": serviceInstanceGuid,
+			"QUEUE_MANAGER_ID":      queueManagerID,
+		})
+		get(&http.Client{Transport: recorder}, testServer.URL, serviceInstanceGuid, queueManagerID)
+		Expect(recorder.Save()).To(Succeed())
+
+		recorder, err = mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{Mode: mqcloudtest.ModeReplay, Path: cassette})
+		Expect(err).To(BeNil())
+		config := recorder.Config()
+		Expect(config["QUEUE_MANAGER_ID"]).ToNot(Equal(config["SERVICE_INSTANCE_GUID"]))
+		get(&http.Client{Transport: recorder}, config["URL"], config["SERVICE_INSTANCE_GUID"], config["QUEUE_MANAGER_ID"])
+		Expect(recorder.Remaining()).To(Equal(0))
+	})
+	It(`Rejects requests that do not match the recording`, func() {
+		record()
+		mqcloudService, recorder, config := replay()
+
+		_, _, err := mqcloudService.CreateUser(mqcloudService.NewCreateUserOptions(config["SERVICE_INSTANCE_GUID"], "bob@example.com", "bob"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("does not match"))
+		Expect(recorder.Remaining()).To(Equal(4))
+
+		_, _, err = mqcloudService.ListUsers(mqcloudService.NewListUsersOptions(config["SERVICE_INSTANCE_GUID"]))
+		Expect(err).ToNot(BeNil())
+		Expect(strings.Contains(err.Error(), http.MethodPost)).To(BeTrue())
+	})
+	It(`Requires a cassette and a mode`, func() {
+		_, err := mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{Mode: mqcloudtest.ModeReplay, Path: cassette})
+		Expect(err).ToNot(BeNil())
+		_, err = mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{Path: cassette})
+		Expect(err).ToNot(BeNil())
+
+		recorder, err := mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{Mode: mqcloudtest.ModeReplay, Path: filepath.Join(dir, "missing.json")})
+		Expect(err).ToNot(BeNil())
+		Expect(recorder).To(BeNil())
+	})
+})
@@ -0,0 +1,387 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mqcloudtest provides an in-memory emulator of the MQ on Cloud API for testing code that uses
+// the mqcloudv1 package without an IBM Cloud account.
+//
+// A Server implements every /v1/{service_instance_guid}/... route used by the SDK and keeps state for
+// queue managers, users, applications, trust and key store certificates and AMS channels. Queue
+// managers move through their statuses (deploying, starting, running; deleting, then gone) as the
+// server's Clock advances, so a ManualClock lets tests step through them. Use it with httptest:
+//
+//	server := mqcloudtest.NewServer(nil)
+//	server.AddServiceInstance(guid)
+//	testServer := httptest.NewServer(server)
+//	err := mqcloudService.SetServiceURL(testServer.URL)
+//
+// Wrap a Server, or any other handler, in a FaultInjector to add errors, latency, timeouts and broken
+// response bodies.
+package mqcloudtest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+)
+
+const (
+	// DefaultPageLimit is the number of resources returned per page when a request sets no limit.
+	DefaultPageLimit = 25
+
+	// MaxPageLimit is the largest limit a list request may ask for.
+	MaxPageLimit = 100
+
+	// DefaultDeployDuration is how long a new queue manager stays in the deploying status.
+	DefaultDeployDuration = 2 * time.Minute
+
+	// DefaultStartDuration is how long a deployed queue manager stays in the starting status.
+	DefaultStartDuration = 30 * time.Second
+
+	// DefaultDeleteDuration is how long a deleted queue manager stays in the deleting status.
+	DefaultDeleteDuration = time.Minute
+
+	// DefaultUpgradeDuration is how long a queue manager stays in the upgrading_version status.
+	DefaultUpgradeDuration = 2 * time.Minute
+
+	// DefaultVpcEntitlement is the VPC entitlement reported by the usage route.
+	DefaultVpcEntitlement = 16
+)
+
+// Options : Settings for NewServer. Zero values are replaced by defaults.
+type Options struct {
+	// The clock that drives status transitions. Defaults to the system clock.
+	Clock Clock
+
+	// The page size used when a list request sets no limit.
+	PageLimit int64
+
+	// How long queue managers spend in each transitional status.
+	DeployDuration  time.Duration
+	StartDuration   time.Duration
+	DeleteDuration  time.Duration
+	UpgradeDuration time.Duration
+
+	// The deployment locations, sizes and versions offered. Versions are in ascending order and the
+	// last one is the latest.
+	Locations []string
+	Sizes     []string
+	Versions  []string
+
+	// The VPC entitlement reported by the usage route.
+	VpcEntitlement float32
+}
+
+// Server : An http.Handler that emulates the MQ on Cloud API. It is safe for concurrent use.
+type Server struct {
+	mutex     sync.Mutex
+	options   Options
+	instances map[string]*serviceInstance
+	nextID    int
+	traces    int
+}
+
+// serviceInstance is the state of one emulated service instance.
+type serviceInstance struct {
+	guid          string
+	queueManagers []*queueManager
+	users         []*user
+	applications  []*application
+}
+
+// NewServer returns an emulator with no service instances.
+func NewServer(options *Options) *Server {
+	server := &Server{instances: map[string]*serviceInstance{}}
+	if options != nil {
+		server.options = *options
+	}
+	if server.options.Clock == nil {
+		server.options.Clock = systemClock{}
+	}
+	if server.options.PageLimit <= 0 {
+		server.options.PageLimit = DefaultPageLimit
+	}
+	if server.options.DeployDuration == 0 {
+		server.options.DeployDuration = DefaultDeployDuration
+	}
+	if server.options.StartDuration == 0 {
+		server.options.StartDuration = DefaultStartDuration
+	}
+	if server.options.DeleteDuration == 0 {
+		server.options.DeleteDuration = DefaultDeleteDuration
+	}
+	if server.options.UpgradeDuration == 0 {
+		server.options.UpgradeDuration = DefaultUpgradeDuration
+	}
+	if len(server.options.Locations) == 0 {
+		server.options.Locations = []string{"reserved-eu-de-cluster-f884"}
+	}
+	if len(server.options.Sizes) == 0 {
+		server.options.Sizes = []string{
+			mqcloudv1.ConfigurationOptions_Sizes_Xsmall,
+			mqcloudv1.ConfigurationOptions_Sizes_Small,
+			mqcloudv1.ConfigurationOptions_Sizes_Medium,
+			mqcloudv1.ConfigurationOptions_Sizes_Large,
+		}
+	}
+	if len(server.options.Versions) == 0 {
+		server.options.Versions = []string{"9.3.4_2", "9.3.5_1", "9.4.0_0"}
+	}
+	if server.options.VpcEntitlement == 0 {
+		server.options.VpcEntitlement = DefaultVpcEntitlement
+	}
+	return server
+}
+
+// AddServiceInstance creates an empty service instance with the given GUID. Requests for a GUID that
+// has not been added get a 404 response. Adding an existing instance does nothing.
+func (server *Server) AddServiceInstance(serviceInstanceGuid string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if _, ok := server.instances[serviceInstanceGuid]; !ok {
+		server.instances[serviceInstanceGuid] = &serviceInstance{guid: serviceInstanceGuid}
+	}
+}
+
+// ServeHTTP handles one API request.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(parts) < 3 || parts[0] != "v1" {
+		server.writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("no route for %s", req.URL.Path))
+		return
+	}
+	instance, ok := server.instances[parts[1]]
+	if !ok {
+		server.writeError(res, http.StatusNotFound, "service_instance_not_found", fmt.Sprintf("service instance %s not found", parts[1]))
+		return
+	}
+	server.settle(instance)
+
+	request := &apiRequest{res: res, req: req, instance: instance, base: baseURL(req) + "/v1/" + instance.guid}
+	route := parts[2:]
+	switch route[0] {
+	case "usage":
+		server.route(request, len(route) == 1, map[string]func(*apiRequest){"GET": server.getUsage})
+	case "options":
+		server.route(request, len(route) == 1, map[string]func(*apiRequest){"GET": server.getOptions})
+	case "queue_managers":
+		server.routeQueueManagers(request, route[1:])
+	case "users":
+		server.routeUsers(request, route[1:])
+	case "applications":
+		server.routeApplications(request, route[1:])
+	default:
+		server.writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("no route for %s", req.URL.Path))
+	}
+}
+
+// apiRequest is one API request being handled, with the service instance it is for.
+type apiRequest struct {
+	res      http.ResponseWriter
+	req      *http.Request
+	instance *serviceInstance
+	base     string
+	ids      []string
+}
+
+// route calls the handler for the request's method, or writes a 404 or 405 response.
+func (server *Server) route(request *apiRequest, matched bool, handlers map[string]func(*apiRequest)) {
+	if !matched {
+		server.writeError(request.res, http.StatusNotFound, "not_found", fmt.Sprintf("no route for %s", request.req.URL.Path))
+		return
+	}
+	handler, ok := handlers[request.req.Method]
+	if !ok {
+		methods := make([]string, 0, len(handlers))
+		for method := range handlers {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		request.res.Header().Set("Allow", strings.Join(methods, ", "))
+		server.writeError(request.res, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s is not supported for %s", request.req.Method, request.req.URL.Path))
+		return
+	}
+	handler(request)
+}
+
+func (server *Server) getUsage(request *apiRequest) {
+	var usage float32
+	for _, qm := range request.instance.queueManagers {
+		usage += sizeUnits[qm.size]
+	}
+	writeJSON(request.res, http.StatusOK, &mqcloudv1.Usage{
+		VpcEntitlement: &server.options.VpcEntitlement,
+		VpcUsage:       &usage,
+	})
+}
+
+func (server *Server) getOptions(request *apiRequest) {
+	writeJSON(request.res, http.StatusOK, &mqcloudv1.ConfigurationOptions{
+		Locations:     server.options.Locations,
+		Sizes:         server.options.Sizes,
+		Versions:      server.options.Versions,
+		LatestVersion: core.StringPtr(server.latestVersion()),
+	})
+}
+
+func (server *Server) latestVersion() string {
+	return server.options.Versions[len(server.options.Versions)-1]
+}
+
+// newID returns a new resource ID. IDs are 32 hex characters like the real service's, and are the same
+// from run to run.
+func (server *Server) newID() string {
+	server.nextID++
+	sum := sha256.Sum256([]byte(fmt.Sprintf("mqcloudtest-id-%d", server.nextID)))
+	return hex.EncodeToString(sum[:16])
+}
+
+// newAPIKey returns a new API key value.
+func (server *Server) newAPIKey() string {
+	server.nextID++
+	sum := sha256.Sum256([]byte(fmt.Sprintf("mqcloudtest-apikey-%d", server.nextID)))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// page reads the offset and limit of a list request and returns the links for the page.
+func (server *Server) page(request *apiRequest, collection string, total int) (offset int, limit int, links pageLinks, ok bool) {
+	query := request.req.URL.Query()
+	limit = int(server.options.PageLimit)
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > MaxPageLimit {
+			server.writeError(request.res, http.StatusBadRequest, "invalid_limit", fmt.Sprintf("limit must be between 1 and %d", MaxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+	if value := query.Get("offset"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			server.writeError(request.res, http.StatusBadRequest, "invalid_offset", "offset must be zero or more")
+			return
+		}
+		offset = parsed
+	}
+
+	href := func(offset int) *string {
+		values := url.Values{}
+		if offset > 0 {
+			values.Set("offset", strconv.Itoa(offset))
+		}
+		values.Set("limit", strconv.Itoa(limit))
+		return core.StringPtr(request.base + "/" + collection + "?" + values.Encode())
+	}
+	links.first = &mqcloudv1.First{Href: href(0)}
+	if offset+limit < total {
+		links.next = &mqcloudv1.Next{Href: href(offset + limit)}
+	}
+	if offset > 0 {
+		previous := offset - limit
+		if previous < 0 {
+			previous = 0
+		}
+		links.previous = &mqcloudv1.Previous{Href: href(previous)}
+	}
+	return offset, limit, links, true
+}
+
+// pageLinks are the pagination links of a collection response.
+type pageLinks struct {
+	first    *mqcloudv1.First
+	next     *mqcloudv1.Next
+	previous *mqcloudv1.Previous
+}
+
+// pageBounds returns the slice bounds of a page.
+func pageBounds(offset int, limit int, total int) (start int, end int) {
+	start = offset
+	if start > total {
+		start = total
+	}
+	end = start + limit
+	if end > total {
+		end = total
+	}
+	return
+}
+
+// decodeBody decodes a JSON request body, writing a 400 response if it is not valid.
+func (server *Server) decodeBody(request *apiRequest, body interface{}) bool {
+	if err := json.NewDecoder(request.req.Body).Decode(body); err != nil {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_body", fmt.Sprintf("the request body is not valid JSON: %s", err.Error()))
+		return false
+	}
+	return true
+}
+
+// apiError is the body of an error response.
+type apiError struct {
+	Errors     []apiErrorDetail `json:"errors"`
+	Trace      string           `json:"trace"`
+	StatusCode int              `json:"status_code"`
+}
+
+type apiErrorDetail struct {
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	MoreInfo string `json:"more_info"`
+}
+
+// writeError writes an error response in the format used by the service.
+func (server *Server) writeError(res http.ResponseWriter, status int, code string, message string) {
+	server.traces++
+	trace := fmt.Sprintf("mqcloudtest-%08d", server.traces)
+	res.Header().Set("X-Request-Id", trace)
+	writeJSON(res, status, &apiError{
+		Errors: []apiErrorDetail{{
+			Code:     code,
+			Message:  message,
+			MoreInfo: "https://cloud.ibm.com/apidocs/mq-on-cloud",
+		}},
+		Trace:      trace,
+		StatusCode: status,
+	})
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(res http.ResponseWriter, status int, body interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(status)
+	_ = json.NewEncoder(res).Encode(body)
+}
+
+// baseURL returns the scheme and host the request was sent to, for building links.
+func baseURL(req *http.Request) string {
+	if req.TLS != nil {
+		return "https://" + req.Host
+	}
+	return "http://" + req.Host
+}
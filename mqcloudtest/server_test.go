@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Server`, func() {
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+	)
+
+	BeforeEach(func() {
+		_, testServer, mqcloudService = startServer(nil)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Rejects unknown service instances, routes and methods`, func() {
+		res, err := http.Get(testServer.URL + "/v1/unknown/users")
+		Expect(err).To(BeNil())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(404))
+		var body struct {
+			Errors []struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"errors"`
+			Trace string `json:"trace"`
+		}
+		Expect(json.NewDecoder(res.Body).Decode(&body)).To(Succeed())
+		Expect(body.Errors[0].Code).To(Equal("service_instance_not_found"))
+		Expect(body.Trace).To(Equal(res.Header.Get("X-Request-Id")))
+
+		res, err = http.Get(testServer.URL + "/v1/" + serviceInstanceGuid + "/queues")
+		Expect(err).To(BeNil())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(404))
+
+		req, _ := http.NewRequest("PATCH", testServer.URL+"/v1/"+serviceInstanceGuid+"/users", nil)
+		res, err = http.DefaultClient.Do(req)
+		Expect(err).To(BeNil())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(405))
+		Expect(res.Header.Get("Allow")).To(Equal("GET, POST"))
+	})
+	It(`Reports usage and configuration options`, func() {
+		configuration, _, err := mqcloudService.GetOptions(mqcloudService.NewGetOptionsOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		Expect(configuration.Sizes).To(ContainElement(mqcloudv1.ConfigurationOptions_Sizes_Small))
+		Expect(*configuration.LatestVersion).To(Equal(configuration.Versions[len(configuration.Versions)-1]))
+
+		_, _, err = mqcloudService.CreateQueueManager(mqcloudService.NewCreateQueueManagerOptions(serviceInstanceGuid, "QM1", configuration.Locations[0], mqcloudv1.CreateQueueManagerOptions_Size_Small))
+		Expect(err).To(BeNil())
+		usage, _, err := mqcloudService.GetUsageDetails(mqcloudService.NewGetUsageDetailsOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		Expect(*usage.VpcUsage).To(BeNumerically("==", 0.5))
+		Expect(*usage.VpcEntitlement).To(BeNumerically("==", 16))
+	})
+	It(`Pages collections with first, next and previous links`, func() {
+		for i := 0; i < 5; i++ {
+			_, _, err := mqcloudService.CreateUser(mqcloudService.NewCreateUserOptions(serviceInstanceGuid, fmt.Sprintf("user%d@example.com", i), fmt.Sprintf("user%d", i)))
+			Expect(err).To(BeNil())
+		}
+
+		users, _, err := mqcloudService.ListUsers(mqcloudService.NewListUsersOptions(serviceInstanceGuid).SetOffset(2).SetLimit(2))
+		Expect(err).To(BeNil())
+		Expect(users.Users).To(HaveLen(2))
+		Expect(*users.Users[0].Name).To(Equal("user2"))
+		Expect(*users.First.Href).To(Equal(testServer.URL + "/v1/" + serviceInstanceGuid + "/users?limit=2"))
+		Expect(*users.Next.Href).To(Equal(testServer.URL + "/v1/" + serviceInstanceGuid + "/users?limit=2&offset=4"))
+		Expect(*users.Previous.Href).To(Equal(testServer.URL + "/v1/" + serviceInstanceGuid + "/users?limit=2"))
+
+		users, _, err = mqcloudService.ListUsers(mqcloudService.NewListUsersOptions(serviceInstanceGuid).SetOffset(4).SetLimit(2))
+		Expect(err).To(BeNil())
+		Expect(users.Users).To(HaveLen(1))
+		Expect(users.Next).To(BeNil())
+
+		pager, err := mqcloudService.NewUsersPager(mqcloudService.NewListUsersOptions(serviceInstanceGuid).SetLimit(2))
+		Expect(err).To(BeNil())
+		all, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(all).To(HaveLen(5))
+
+		_, response, err := mqcloudService.ListUsers(mqcloudService.NewListUsersOptions(serviceInstanceGuid).SetLimit(500))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+	})
+})
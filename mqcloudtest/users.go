@@ -0,0 +1,279 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+)
+
+var shortnamePattern = regexp.MustCompile(`^[a-z][a-z0-9]{0,11}$`)
+
+// user is an emulated service instance user.
+type user struct {
+	id    string
+	name  string
+	email string
+}
+
+// application is an emulated service instance application.
+type application struct {
+	id   string
+	name string
+}
+
+// applicationCreated is the body of a create application response. The SDK's ApplicationCreated
+// redacts the API key when marshalled, so it cannot be used here.
+type applicationCreated struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	CreateApiKeyURI string `json:"create_api_key_uri"`
+	Href            string `json:"href"`
+	ApiKeyName      string `json:"api_key_name"`
+	ApiKeyID        string `json:"api_key_id"`
+	ApiKey          string `json:"api_key"`
+}
+
+// apiKeyCreated is the body of a create API key response.
+type apiKeyCreated struct {
+	ApiKeyName string `json:"api_key_name"`
+	ApiKeyID   string `json:"api_key_id"`
+	ApiKey     string `json:"api_key"`
+}
+
+func (server *Server) routeUsers(request *apiRequest, route []string) {
+	if len(route) == 0 {
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET":  server.listUsers,
+			"POST": server.createUser,
+		})
+		return
+	}
+	var found *user
+	for _, u := range request.instance.users {
+		if u.id == route[0] {
+			found = u
+		}
+	}
+	if found == nil {
+		server.writeError(request.res, http.StatusNotFound, "user_not_found", fmt.Sprintf("user %s not found", route[0]))
+		return
+	}
+	server.route(request, len(route) == 1, map[string]func(*apiRequest){
+		"GET": func(request *apiRequest) {
+			writeJSON(request.res, http.StatusOK, userDetails(request, found))
+		},
+		"DELETE": func(request *apiRequest) {
+			users := []*user{}
+			for _, u := range request.instance.users {
+				if u != found {
+					users = append(users, u)
+				}
+			}
+			request.instance.users = users
+			request.res.WriteHeader(http.StatusNoContent)
+		},
+	})
+}
+
+func (server *Server) listUsers(request *apiRequest) {
+	users := request.instance.users
+	offset, limit, links, ok := server.page(request, "users", len(users))
+	if !ok {
+		return
+	}
+	start, end := pageBounds(offset, limit, len(users))
+	collection := &mqcloudv1.UserDetailsCollection{
+		Offset:   core.Int64Ptr(int64(offset)),
+		Limit:    core.Int64Ptr(int64(limit)),
+		First:    links.first,
+		Next:     links.next,
+		Previous: links.previous,
+		Users:    []mqcloudv1.UserDetails{},
+	}
+	for _, u := range users[start:end] {
+		collection.Users = append(collection.Users, *userDetails(request, u))
+	}
+	writeJSON(request.res, http.StatusOK, collection)
+}
+
+func (server *Server) createUser(request *apiRequest) {
+	var body struct {
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	}
+	if !server.decodeBody(request, &body) {
+		return
+	}
+	if !strings.Contains(body.Email, "@") {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_email", fmt.Sprintf("email %q is not valid", body.Email))
+		return
+	}
+	if !shortnamePattern.MatchString(body.Name) {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_name", fmt.Sprintf("user name %q is not valid", body.Name))
+		return
+	}
+	for _, u := range request.instance.users {
+		if strings.EqualFold(u.email, body.Email) || u.name == body.Name {
+			server.writeError(request.res, http.StatusConflict, "user_exists", fmt.Sprintf("a user with email %s or name %s already exists", body.Email, body.Name))
+			return
+		}
+	}
+	u := &user{id: server.newID(), name: body.Name, email: body.Email}
+	request.instance.users = append(request.instance.users, u)
+	writeJSON(request.res, http.StatusCreated, userDetails(request, u))
+}
+
+func userDetails(request *apiRequest, u *user) *mqcloudv1.UserDetails {
+	return &mqcloudv1.UserDetails{
+		ID:    core.StringPtr(u.id),
+		Name:  core.StringPtr(u.name),
+		Email: core.StringPtr(u.email),
+		Href:  core.StringPtr(request.base + "/users/" + u.id),
+	}
+}
+
+func (server *Server) routeApplications(request *apiRequest, route []string) {
+	if len(route) == 0 {
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET":  server.listApplications,
+			"POST": server.createApplication,
+		})
+		return
+	}
+	var found *application
+	for _, app := range request.instance.applications {
+		if app.id == route[0] {
+			found = app
+		}
+	}
+	if found == nil {
+		server.writeError(request.res, http.StatusNotFound, "application_not_found", fmt.Sprintf("application %s not found", route[0]))
+		return
+	}
+	switch {
+	case len(route) == 1:
+		server.route(request, true, map[string]func(*apiRequest){
+			"GET": func(request *apiRequest) {
+				writeJSON(request.res, http.StatusOK, applicationDetails(request, found))
+			},
+			"DELETE": func(request *apiRequest) {
+				applications := []*application{}
+				for _, app := range request.instance.applications {
+					if app != found {
+						applications = append(applications, app)
+					}
+				}
+				request.instance.applications = applications
+				request.res.WriteHeader(http.StatusNoContent)
+			},
+		})
+	case len(route) == 2 && route[1] == "api_key":
+		server.route(request, true, map[string]func(*apiRequest){
+			"POST": func(request *apiRequest) {
+				server.createApiKey(request)
+			},
+		})
+	default:
+		server.route(request, false, nil)
+	}
+}
+
+func (server *Server) listApplications(request *apiRequest) {
+	applications := request.instance.applications
+	offset, limit, links, ok := server.page(request, "applications", len(applications))
+	if !ok {
+		return
+	}
+	start, end := pageBounds(offset, limit, len(applications))
+	collection := &mqcloudv1.ApplicationDetailsCollection{
+		Offset:       core.Int64Ptr(int64(offset)),
+		Limit:        core.Int64Ptr(int64(limit)),
+		First:        links.first,
+		Next:         links.next,
+		Previous:     links.previous,
+		Applications: []mqcloudv1.ApplicationDetails{},
+	}
+	for _, app := range applications[start:end] {
+		collection.Applications = append(collection.Applications, *applicationDetails(request, app))
+	}
+	writeJSON(request.res, http.StatusOK, collection)
+}
+
+func (server *Server) createApplication(request *apiRequest) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if !server.decodeBody(request, &body) {
+		return
+	}
+	if !shortnamePattern.MatchString(body.Name) {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_name", fmt.Sprintf("application name %q is not valid", body.Name))
+		return
+	}
+	for _, app := range request.instance.applications {
+		if app.name == body.Name {
+			server.writeError(request.res, http.StatusConflict, "application_exists", fmt.Sprintf("an application named %s already exists", body.Name))
+			return
+		}
+	}
+	app := &application{id: server.newID(), name: body.Name}
+	request.instance.applications = append(request.instance.applications, app)
+	details := applicationDetails(request, app)
+	writeJSON(request.res, http.StatusCreated, &applicationCreated{
+		ID:              app.id,
+		Name:            app.name,
+		CreateApiKeyURI: *details.CreateApiKeyURI,
+		Href:            *details.Href,
+		ApiKeyName:      app.name,
+		ApiKeyID:        server.newID(),
+		ApiKey:          server.newAPIKey(),
+	})
+}
+
+func (server *Server) createApiKey(request *apiRequest) {
+	var body struct {
+		Name string `json:"name"`
+	}
+	if !server.decodeBody(request, &body) {
+		return
+	}
+	if body.Name == "" {
+		server.writeError(request.res, http.StatusBadRequest, "invalid_name", "the API key name is required")
+		return
+	}
+	writeJSON(request.res, http.StatusCreated, &apiKeyCreated{
+		ApiKeyName: body.Name,
+		ApiKeyID:   server.newID(),
+		ApiKey:     server.newAPIKey(),
+	})
+}
+
+func applicationDetails(request *apiRequest, app *application) *mqcloudv1.ApplicationDetails {
+	href := request.base + "/applications/" + app.id
+	return &mqcloudv1.ApplicationDetails{
+		ID:              core.StringPtr(app.id),
+		Name:            core.StringPtr(app.name),
+		CreateApiKeyURI: core.StringPtr(href + "/api_key"),
+		Href:            core.StringPtr(href),
+	}
+}
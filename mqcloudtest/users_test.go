@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudtest_test
+
+import (
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Users and applications`, func() {
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+	)
+
+	BeforeEach(func() {
+		_, testServer, mqcloudService = startServer(nil)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Imports, finds and deletes users`, func() {
+		records := []mqcloudv1.UserRecord{
+			{Email: "alice@example.com", Name: "alice"},
+			{Email: "bob@example.com", Name: "bob"},
+		}
+		report, err := mqcloudService.ImportUsers(&mqcloudv1.ImportUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Records:             records,
+		})
+		Expect(err).To(BeNil())
+		Expect(report.Counts()[mqcloudv1.UserImportResult_Status_Created]).To(Equal(2))
+
+		_, response, err := mqcloudService.CreateUser(mqcloudService.NewCreateUserOptions(serviceInstanceGuid, "ALICE@example.com", "alice2"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		user, err := mqcloudService.FindUserByEmail(mqcloudService.NewFindUserByEmailOptions(serviceInstanceGuid, "bob@example.com"))
+		Expect(err).To(BeNil())
+		fetched, _, err := mqcloudService.GetUser(mqcloudService.NewGetUserOptions(serviceInstanceGuid, *user.ID))
+		Expect(err).To(BeNil())
+		Expect(*fetched.Name).To(Equal("bob"))
+
+		response, err = mqcloudService.DeleteUser(mqcloudService.NewDeleteUserOptions(serviceInstanceGuid, *user.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+		_, response, err = mqcloudService.GetUser(mqcloudService.NewGetUserOptions(serviceInstanceGuid, *user.ID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Creates applications and API keys`, func() {
+		created, response, err := mqcloudService.CreateApplication(mqcloudService.NewCreateApplicationOptions(serviceInstanceGuid, "orders"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(created.ApiKey.Reveal()).ToNot(BeEmpty())
+		Expect(*created.CreateApiKeyURI).To(Equal(*created.Href + "/api_key"))
+
+		key, _, err := mqcloudService.CreateApplicationApikey(mqcloudService.NewCreateApplicationApikeyOptions(serviceInstanceGuid, *created.ID, "rotated"))
+		Expect(err).To(BeNil())
+		Expect(*key.ApiKeyName).To(Equal("rotated"))
+		Expect(key.ApiKey.Reveal()).ToNot(Equal(created.ApiKey.Reveal()))
+
+		applications, _, err := mqcloudService.ListApplications(mqcloudService.NewListApplicationsOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		Expect(applications.Applications).To(HaveLen(1))
+
+		response, err = mqcloudService.DeleteApplication(mqcloudService.NewDeleteApplicationOptions(serviceInstanceGuid, *created.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+		_, err = mqcloudService.FindApplicationByName(mqcloudService.NewFindApplicationByNameOptions(serviceInstanceGuid, "orders"))
+		Expect(err).ToNot(BeNil())
+	})
+})
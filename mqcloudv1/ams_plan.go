@@ -0,0 +1,416 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// AmsChannelStep : One SetCertificateAmsChannels call in an AMS channel plan.
+type AmsChannelStep struct {
+	// The id of the key store certificate.
+	CertificateID string
+
+	// Certificate label in queue manager store.
+	Label string
+
+	// The update strategy to use, SetCertificateAmsChannelsOptions_UpdateStrategy_Append or _Replace.
+	UpdateStrategy string
+
+	// The channels to send: the new channels when appending, the remaining channels when replacing.
+	Channels []string
+
+	// Channels this step assigns to the certificate.
+	Added []string
+
+	// Channels this step removes from the certificate.
+	Removed []string
+}
+
+// String describes the step as the call it will make.
+func (step AmsChannelStep) String() string {
+	return fmt.Sprintf("%s %s (%s): [%s]", step.UpdateStrategy, step.Label, step.CertificateID, strings.Join(step.Channels, ", "))
+}
+
+// AmsChannelConflict : A channel that is assigned to more than one certificate.
+type AmsChannelConflict struct {
+	// The name of the channel.
+	Channel string
+
+	// The ids of the certificates the channel is currently assigned to.
+	CertificateIDs []string
+
+	// True if the plan leaves the channel assigned to exactly one certificate.
+	Resolved bool
+}
+
+// AmsChannelPlan : The calls needed to move a queue manager's AMS channel assignments to a desired state.
+type AmsChannelPlan struct {
+	// The queue manager the plan applies to.
+	QueueManager QueueManagerRef
+
+	// The current channels of every key store certificate, keyed by certificate id.
+	Current map[string][]string
+
+	// The desired channels, keyed by certificate id. Certificates that are not listed keep their channels.
+	Desired map[string][]string
+
+	// Channels assigned to more than one certificate, now or after the plan is applied.
+	Conflicts []AmsChannelConflict
+
+	// The calls to make, in order. Steps that only remove channels come first, then steps that only
+	// add them, so that a channel moving between certificates is never assigned to both.
+	Steps []AmsChannelStep
+
+	labels map[string]string
+}
+
+// Empty returns true if the queue manager is already in the desired state.
+func (plan *AmsChannelPlan) Empty() bool {
+	return len(plan.Steps) == 0
+}
+
+// Unresolved returns the conflicts that remain after the plan is applied.
+func (plan *AmsChannelPlan) Unresolved() (conflicts []AmsChannelConflict) {
+	for _, conflict := range plan.Conflicts {
+		if !conflict.Resolved {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return
+}
+
+// Diff renders the changes the plan makes, one certificate per block, with added channels prefixed
+// by "+", removed channels by "-" and unchanged channels by a space.
+func (plan *AmsChannelPlan) Diff() string {
+	// A certificate can have a removal step and an append step; its changes are shown together.
+	type change struct {
+		label   string
+		added   []string
+		removed []string
+	}
+	var ids []string
+	changes := make(map[string]*change)
+	for _, step := range plan.Steps {
+		c, ok := changes[step.CertificateID]
+		if !ok {
+			c = &change{label: step.Label}
+			changes[step.CertificateID] = c
+			ids = append(ids, step.CertificateID)
+		}
+		c.added = append(c.added, step.Added...)
+		c.removed = append(c.removed, step.Removed...)
+	}
+
+	var diff strings.Builder
+	for _, id := range ids {
+		c := changes[id]
+		fmt.Fprintf(&diff, "certificate %s (%s):\n", c.label, id)
+		removed := stringSet(c.removed)
+		added := stringSet(c.added)
+		for _, channel := range mergeSorted(plan.Current[id], c.added) {
+			switch {
+			case added[channel]:
+				fmt.Fprintf(&diff, "+ %s\n", channel)
+			case removed[channel]:
+				fmt.Fprintf(&diff, "- %s\n", channel)
+			default:
+				fmt.Fprintf(&diff, "  %s\n", channel)
+			}
+		}
+	}
+	for _, conflict := range plan.Unresolved() {
+		fmt.Fprintf(&diff, "! %s remains assigned to %s\n", conflict.Channel, strings.Join(conflict.CertificateIDs, ", "))
+	}
+	return diff.String()
+}
+
+// PlanAmsChannels : Plan the AMS channel assignments of a queue manager's key store certificates
+// Fetches the AMS channels of every key store certificate and computes the smallest sequence of
+// SetCertificateAmsChannels calls that gives each certificate in desired exactly the listed channels.
+// Certificates are identified by id or label. Nothing is changed; use ApplyAmsChannelPlan to apply it.
+func (mqcloud *MqcloudV1) PlanAmsChannels(qm QueueManagerRef, desired map[string][]string) (plan *AmsChannelPlan, err error) {
+	plan, err = mqcloud.PlanAmsChannelsWithContext(context.Background(), qm, desired)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanAmsChannelsWithContext is an alternate form of the PlanAmsChannels method which supports a Context parameter
+func (mqcloud *MqcloudV1) PlanAmsChannelsWithContext(ctx context.Context, qm QueueManagerRef, desired map[string][]string) (plan *AmsChannelPlan, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "PlanAmsChannels", qm)
+	defer func() {
+		end(err)
+	}()
+
+	if qm.ServiceInstanceGuid == "" || qm.QueueManagerID == "" {
+		err = core.SDKErrorf(nil, "queue manager references must have a service instance guid and a queue manager id", "invalid-queue-manager-ref", common.GetComponentInfo())
+		return
+	}
+
+	keyStore, _, err := mqcloud.ListKeyStoreCertificatesWithContext(ctx, &ListKeyStoreCertificatesOptions{
+		ServiceInstanceGuid: core.StringPtr(qm.ServiceInstanceGuid),
+		QueueManagerID:      core.StringPtr(qm.QueueManagerID),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "key-store-list-error")
+		return
+	}
+
+	plan = &AmsChannelPlan{
+		QueueManager: qm,
+		Current:      make(map[string][]string),
+		Desired:      make(map[string][]string),
+		labels:       make(map[string]string),
+	}
+	byLabel := make(map[string]string)
+	var ids []string
+	for _, cert := range keyStore.KeyStore {
+		if cert.ID == nil {
+			continue
+		}
+		id := *cert.ID
+		var channels *ChannelsDetails
+		channels, _, err = mqcloud.GetCertificateAmsChannelsWithContext(ctx, &GetCertificateAmsChannelsOptions{
+			ServiceInstanceGuid: core.StringPtr(qm.ServiceInstanceGuid),
+			QueueManagerID:      core.StringPtr(qm.QueueManagerID),
+			CertificateID:       core.StringPtr(id),
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "ams-channels-get-error")
+			return nil, err
+		}
+		var names []string
+		for _, channel := range channels.Channels {
+			if channel.Name != nil {
+				names = append(names, *channel.Name)
+			}
+		}
+		ids = append(ids, id)
+		plan.Current[id] = normalizeChannels(names)
+		plan.labels[id] = core.StringNilMapper(cert.Label)
+		if cert.Label != nil {
+			byLabel[*cert.Label] = id
+		}
+	}
+
+	// The desired entries are taken in key order, so that errors about them are the same every run.
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	owner := make(map[string]string)
+	listedAs := make(map[string]string)
+	for _, key := range keys {
+		channels := desired[key]
+		id := key
+		if _, ok := plan.Current[id]; !ok {
+			if id, ok = byLabel[key]; !ok {
+				err = core.SDKErrorf(nil, fmt.Sprintf("queue manager %s has no key store certificate with id or label '%s'", qm, key), "unknown-certificate", common.GetComponentInfo())
+				return nil, err
+			}
+		}
+		if other, ok := listedAs[id]; ok {
+			err = core.SDKErrorf(nil, fmt.Sprintf("certificate %s is listed more than once, as '%s' and '%s'", id, other, key), "duplicate-certificate", common.GetComponentInfo())
+			return nil, err
+		}
+		listedAs[id] = key
+		plan.Desired[id] = normalizeChannels(channels)
+		for _, channel := range plan.Desired[id] {
+			if other, ok := owner[channel]; ok {
+				err = core.SDKErrorf(nil, fmt.Sprintf("channel %s is desired on both certificate %s and %s", channel, other, id), "desired-channel-conflict", common.GetComponentInfo())
+				return nil, err
+			}
+			owner[channel] = id
+		}
+	}
+
+	// A certificate that both loses and gains channels gets two steps, so that no channel it gains
+	// is assigned to it before the certificate giving the channel up has lost it.
+	var removals, appends []AmsChannelStep
+	for _, id := range ids {
+		want, ok := plan.Desired[id]
+		if !ok {
+			continue
+		}
+		if removed := subtractChannels(plan.Current[id], want); len(removed) > 0 {
+			removals = append(removals, AmsChannelStep{
+				CertificateID:  id,
+				Label:          plan.labels[id],
+				UpdateStrategy: SetCertificateAmsChannelsOptions_UpdateStrategy_Replace,
+				Channels:       append([]string{}, subtractChannels(plan.Current[id], removed)...),
+				Removed:        removed,
+			})
+		}
+		if added := subtractChannels(want, plan.Current[id]); len(added) > 0 {
+			appends = append(appends, AmsChannelStep{
+				CertificateID:  id,
+				Label:          plan.labels[id],
+				UpdateStrategy: SetCertificateAmsChannelsOptions_UpdateStrategy_Append,
+				Channels:       added,
+				Added:          added,
+			})
+		}
+	}
+	plan.Steps = append(removals, appends...)
+	plan.Conflicts = plan.conflicts(ids)
+	return
+}
+
+// conflicts finds channels held by more than one certificate before the plan and checks whether the
+// plan leaves each of them with a single certificate.
+func (plan *AmsChannelPlan) conflicts(ids []string) (conflicts []AmsChannelConflict) {
+	before := make(map[string][]string)
+	after := make(map[string]int)
+	for _, id := range ids {
+		for _, channel := range plan.Current[id] {
+			before[channel] = append(before[channel], id)
+		}
+		final, ok := plan.Desired[id]
+		if !ok {
+			final = plan.Current[id]
+		}
+		for _, channel := range final {
+			after[channel]++
+		}
+	}
+
+	channels := make([]string, 0, len(before)+len(after))
+	for channel := range before {
+		channels = append(channels, channel)
+	}
+	for channel := range after {
+		if _, ok := before[channel]; !ok {
+			channels = append(channels, channel)
+		}
+	}
+	sort.Strings(channels)
+
+	for _, channel := range channels {
+		if len(before[channel]) <= 1 && after[channel] <= 1 {
+			continue
+		}
+		conflict := AmsChannelConflict{
+			Channel:  channel,
+			Resolved: after[channel] <= 1,
+		}
+		for _, id := range ids {
+			final, ok := plan.Desired[id]
+			if !ok {
+				final = plan.Current[id]
+			}
+			if stringSet(plan.Current[id])[channel] || stringSet(final)[channel] {
+				conflict.CertificateIDs = append(conflict.CertificateIDs, id)
+			}
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	return
+}
+
+// ApplyAmsChannelPlan : Apply an AMS channel plan
+// Makes the SetCertificateAmsChannels calls of a plan from PlanAmsChannels, in order. A plan that
+// would leave a channel assigned to more than one certificate is rejected. On error, the steps that
+// were applied before the failure are returned.
+func (mqcloud *MqcloudV1) ApplyAmsChannelPlan(plan *AmsChannelPlan) (applied []AmsChannelStep, err error) {
+	applied, err = mqcloud.ApplyAmsChannelPlanWithContext(context.Background(), plan)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyAmsChannelPlanWithContext is an alternate form of the ApplyAmsChannelPlan method which supports a Context parameter
+func (mqcloud *MqcloudV1) ApplyAmsChannelPlanWithContext(ctx context.Context, plan *AmsChannelPlan) (applied []AmsChannelStep, err error) {
+	err = core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	ctx, end := mqcloud.startSpan(ctx, "ApplyAmsChannelPlan", plan.QueueManager)
+	defer func() {
+		end(err)
+	}()
+	if unresolved := plan.Unresolved(); len(unresolved) > 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("channel %s would remain assigned to certificates %s", unresolved[0].Channel, strings.Join(unresolved[0].CertificateIDs, ", ")), "ams-channel-conflict", common.GetComponentInfo())
+		return
+	}
+
+	for _, step := range plan.Steps {
+		channels := make([]ChannelDetails, len(step.Channels))
+		for i := range step.Channels {
+			channels[i] = ChannelDetails{Name: core.StringPtr(step.Channels[i])}
+		}
+		_, _, err = mqcloud.SetCertificateAmsChannelsWithContext(ctx, &SetCertificateAmsChannelsOptions{
+			ServiceInstanceGuid: core.StringPtr(plan.QueueManager.ServiceInstanceGuid),
+			QueueManagerID:      core.StringPtr(plan.QueueManager.QueueManagerID),
+			CertificateID:       core.StringPtr(step.CertificateID),
+			Channels:            channels,
+			UpdateStrategy:      core.StringPtr(step.UpdateStrategy),
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "ams-channels-set-error")
+			return
+		}
+		applied = append(applied, step)
+	}
+	return
+}
+
+// normalizeChannels trims, de-duplicates and sorts channel names, dropping empty ones.
+func normalizeChannels(channels []string) []string {
+	normalized := []string{}
+	seen := make(map[string]bool)
+	for _, channel := range channels {
+		channel = strings.TrimSpace(channel)
+		if channel == "" || seen[channel] {
+			continue
+		}
+		seen[channel] = true
+		normalized = append(normalized, channel)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// subtractChannels returns the channels in a that are not in b.
+func subtractChannels(a []string, b []string) (result []string) {
+	exclude := stringSet(b)
+	for _, channel := range a {
+		if !exclude[channel] {
+			result = append(result, channel)
+		}
+	}
+	return
+}
+
+// mergeSorted returns the sorted union of a and b.
+func mergeSorted(a []string, b []string) []string {
+	return normalizeChannels(append(append([]string{}, a...), b...))
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
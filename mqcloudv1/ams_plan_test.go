@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`AMS channel planning`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		channels       map[string][]string
+		labels         map[string]string
+		setCalls       []string
+		qm             = mqcloudv1.QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuid, QueueManagerID: "qm1"}
+	)
+
+	BeforeEach(func() {
+		channels = map[string][]string{"c1": {"APP.A", "APP.B"}, "c2": {}, "c3": {"APP.C"}}
+		labels = map[string]string{"c1": "old", "c2": "new", "c3": "other"}
+		setCalls = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			path := strings.TrimPrefix(req.URL.Path, "/v1/"+serviceInstanceGuid+"/queue_managers/qm1/certificates/key_store")
+			res.Header().Set("Content-type", "application/json")
+			if path == "" {
+				var keyStore []map[string]interface{}
+				for _, id := range []string{"c1", "c2", "c3"} {
+					keyStore = append(keyStore, map[string]interface{}{"id": id, "label": labels[id]})
+				}
+				body, _ := json.Marshal(map[string]interface{}{"total_count": len(keyStore), "key_store": keyStore})
+				_, _ = res.Write(body)
+				return
+			}
+			id := strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/config/ams")
+			Expect(channels).To(HaveKey(id))
+			if req.Method == "PUT" {
+				var body struct {
+					Channels       []mqcloudv1.ChannelDetails `json:"channels"`
+					UpdateStrategy string                     `json:"update_strategy"`
+				}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				var names []string
+				for _, channel := range body.Channels {
+					names = append(names, *channel.Name)
+				}
+				setCalls = append(setCalls, fmt.Sprintf("%s %s %v", body.UpdateStrategy, id, names))
+				if body.UpdateStrategy == "replace" {
+					channels[id] = names
+				} else {
+					channels[id] = append(channels[id], names...)
+				}
+				sort.Strings(channels[id])
+			}
+			var details []map[string]string
+			for _, name := range channels[id] {
+				details = append(details, map[string]string{"name": name})
+			}
+			body, _ := json.Marshal(map[string]interface{}{"channels": details})
+			_, _ = res.Write(body)
+		}))
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Plans the minimal calls and applies them`, func() {
+		plan, err := mqcloudService.PlanAmsChannels(qm, map[string][]string{
+			"new": {"APP.D", "APP.B"},
+			"c1":  {"APP.A"},
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Current).To(HaveLen(3))
+		Expect(plan.Conflicts).To(BeEmpty())
+		Expect(plan.Steps).To(HaveLen(2))
+		Expect(plan.Steps[0].UpdateStrategy).To(Equal(mqcloudv1.SetCertificateAmsChannelsOptions_UpdateStrategy_Replace))
+		Expect(plan.Steps[0].Removed).To(Equal([]string{"APP.B"}))
+		Expect(plan.Steps[1].UpdateStrategy).To(Equal(mqcloudv1.SetCertificateAmsChannelsOptions_UpdateStrategy_Append))
+		Expect(plan.Steps[1].Channels).To(Equal([]string{"APP.B", "APP.D"}))
+		Expect(plan.Diff()).To(Equal("certificate old (c1):\n  APP.A\n- APP.B\ncertificate new (c2):\n+ APP.B\n+ APP.D\n"))
+		Expect(setCalls).To(BeEmpty())
+
+		applied, err := mqcloudService.ApplyAmsChannelPlan(plan)
+		Expect(err).To(BeNil())
+		Expect(applied).To(HaveLen(2))
+		Expect(setCalls).To(Equal([]string{"replace c1 [APP.A]", "append c2 [APP.B APP.D]"}))
+		Expect(channels["c2"]).To(Equal([]string{"APP.B", "APP.D"}))
+
+		plan, err = mqcloudService.PlanAmsChannels(qm, map[string][]string{"new": {"APP.B", "APP.D"}, "c1": {"APP.A"}})
+		Expect(err).To(BeNil())
+		Expect(plan.Empty()).To(BeTrue())
+	})
+	It(`Moves channels between certificates without assigning them twice`, func() {
+		var overlaps []string
+		assigned := func() {
+			holders := map[string]int{}
+			for _, names := range channels {
+				for _, name := range names {
+					if holders[name]++; holders[name] > 1 {
+						overlaps = append(overlaps, name)
+					}
+				}
+			}
+		}
+
+		plan, err := mqcloudService.PlanAmsChannels(qm, map[string][]string{
+			"c1": {"APP.A", "APP.C"},
+			"c3": {"APP.B"},
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Diff()).To(Equal("certificate old (c1):\n  APP.A\n- APP.B\n+ APP.C\ncertificate other (c3):\n+ APP.B\n- APP.C\n"))
+
+		for _, step := range plan.Steps {
+			_, err = mqcloudService.ApplyAmsChannelPlan(&mqcloudv1.AmsChannelPlan{QueueManager: qm, Steps: []mqcloudv1.AmsChannelStep{step}})
+			Expect(err).To(BeNil())
+			assigned()
+		}
+		Expect(overlaps).To(BeEmpty())
+		Expect(setCalls).To(Equal([]string{"replace c1 [APP.A]", "replace c3 []", "append c1 [APP.C]", "append c3 [APP.B]"}))
+		Expect(channels["c1"]).To(Equal([]string{"APP.A", "APP.C"}))
+		Expect(channels["c3"]).To(Equal([]string{"APP.B"}))
+	})
+	It(`Detects channels assigned to two certificates`, func() {
+		channels["c3"] = []string{"APP.A", "APP.C"}
+
+		plan, err := mqcloudService.PlanAmsChannels(qm, map[string][]string{"c1": {"APP.A", "APP.B"}})
+		Expect(err).To(BeNil())
+		Expect(plan.Conflicts).To(HaveLen(1))
+		Expect(plan.Conflicts[0].Channel).To(Equal("APP.A"))
+		Expect(plan.Conflicts[0].CertificateIDs).To(Equal([]string{"c1", "c3"}))
+		Expect(plan.Unresolved()).To(HaveLen(1))
+		Expect(plan.Diff()).To(ContainSubstring("! APP.A remains assigned to c1, c3"))
+		_, err = mqcloudService.ApplyAmsChannelPlan(plan)
+		Expect(err).ToNot(BeNil())
+		Expect(setCalls).To(BeEmpty())
+
+		plan, err = mqcloudService.PlanAmsChannels(qm, map[string][]string{"c1": {"APP.A", "APP.B"}, "other": {"APP.C"}})
+		Expect(err).To(BeNil())
+		Expect(plan.Conflicts).To(HaveLen(1))
+		Expect(plan.Conflicts[0].Resolved).To(BeTrue())
+		_, err = mqcloudService.ApplyAmsChannelPlan(plan)
+		Expect(err).To(BeNil())
+		Expect(setCalls).To(Equal([]string{"replace c3 [APP.C]"}))
+	})
+	It(`Rejects invalid desired states`, func() {
+		_, err := mqcloudService.PlanAmsChannels(qm, map[string][]string{"missing": {"APP.A"}})
+		Expect(err).ToNot(BeNil())
+		_, err = mqcloudService.PlanAmsChannels(qm, map[string][]string{"c1": {"APP.A"}, "c2": {"APP.A"}})
+		Expect(err).ToNot(BeNil())
+		_, err = mqcloudService.PlanAmsChannels(qm, map[string][]string{"c1": {"APP.A"}, "old": {"APP.B"}})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Reports the same error for invalid desired states every time`, func() {
+		for i := 0; i < 20; i++ {
+			_, err := mqcloudService.PlanAmsChannels(qm, map[string][]string{"old": {"APP.B"}, "c1": {"APP.A"}, "c3": {"APP.C"}})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("certificate c1 is listed more than once, as 'c1' and 'old'"))
+
+			_, err = mqcloudService.PlanAmsChannels(qm, map[string][]string{"c3": {"APP.A"}, "c2": {"APP.A"}, "c1": {"APP.A"}})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("channel APP.A is desired on both certificate c1 and c2"))
+		}
+	})
+})
@@ -0,0 +1,321 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// DefaultAmsExpiryWarning is how close to expiry a certificate must be for its channels to be flagged
+// when AmsReportOptions.ExpiryWarning is not set.
+const DefaultAmsExpiryWarning = 30 * 24 * time.Hour
+
+// Constants for AmsReportEntry.Status.
+const (
+	AmsReportEntry_Status_Ok            = "ok"
+	AmsReportEntry_Status_Expiring      = "expiring"
+	AmsReportEntry_Status_Expired       = "expired"
+	AmsReportEntry_Status_NoCertificate = "no_certificate"
+)
+
+// AmsReportOptions : Settings for GenerateAmsReport.
+type AmsReportOptions struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid string
+
+	// The ids of the queue managers to report on. All queue managers in the service instance are
+	// reported on when empty.
+	QueueManagerIDs []string
+
+	// Channels that every reported queue manager is expected to protect with AMS. Any of them with no
+	// certificate on a queue manager is reported with status "no_certificate".
+	ExpectedChannels []string
+
+	// How close to expiry a certificate must be for its channels to be flagged. Defaults to DefaultAmsExpiryWarning.
+	ExpiryWarning time.Duration
+
+	// The time to evaluate expiry against. Defaults to the current time.
+	Now time.Time
+}
+
+// AmsReportEntry : One channel, on one queue manager, protected by one certificate.
+type AmsReportEntry struct {
+	// The name of the channel.
+	Channel string `json:"channel"`
+
+	// The ID of the queue manager.
+	QueueManagerID string `json:"queue_manager_id"`
+
+	// The name of the queue manager.
+	QueueManagerName string `json:"queue_manager_name"`
+
+	// The id of the key store certificate, empty if the channel has none.
+	CertificateID string `json:"certificate_id,omitempty"`
+
+	// Certificate label in queue manager store.
+	CertificateLabel string `json:"certificate_label,omitempty"`
+
+	// Subject's Distinguished Name.
+	SubjectDn string `json:"subject_dn,omitempty"`
+
+	// Issuer's Distinguished Name.
+	IssuerDn string `json:"issuer_dn,omitempty"`
+
+	// Expiry date for the certificate.
+	Expiry *time.Time `json:"expiry,omitempty"`
+
+	// One of the AmsReportEntry_Status_* constants.
+	Status string `json:"status"`
+}
+
+// Flagged returns true if the entry needs attention.
+func (entry AmsReportEntry) Flagged() bool {
+	return entry.Status != AmsReportEntry_Status_Ok
+}
+
+// AmsReport : Which AMS protected channels use which certificate, across queue managers.
+type AmsReport struct {
+	// The time expiry was evaluated against.
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// The entries, ordered by channel, then queue manager name, then certificate label.
+	Entries []AmsReportEntry `json:"entries"`
+}
+
+// Flagged returns the entries for channels with no certificate or with an expiring or expired one.
+func (report *AmsReport) Flagged() (entries []AmsReportEntry) {
+	for _, entry := range report.Entries {
+		if entry.Flagged() {
+			entries = append(entries, entry)
+		}
+	}
+	return
+}
+
+// GenerateAmsReport : Report on AMS channel protection across queue managers
+// Collects the AMS channels of every key store certificate of each queue manager and joins them with
+// the certificate subject, issuer and expiry, giving a channel-centric view.
+func (mqcloud *MqcloudV1) GenerateAmsReport(options *AmsReportOptions) (report *AmsReport, err error) {
+	report, err = mqcloud.GenerateAmsReportWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GenerateAmsReportWithContext is an alternate form of the GenerateAmsReport method which supports a Context parameter
+func (mqcloud *MqcloudV1) GenerateAmsReportWithContext(ctx context.Context, options *AmsReportOptions) (report *AmsReport, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "GenerateAmsReport", QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuidOf(options)})
+	defer func() {
+		end(err)
+	}()
+
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.ServiceInstanceGuid == "" {
+		err = core.SDKErrorf(nil, "a service instance guid is required", "missing-service-instance-guid", common.GetComponentInfo())
+		return
+	}
+	warning := options.ExpiryWarning
+	if warning <= 0 {
+		warning = DefaultAmsExpiryWarning
+	}
+	now := options.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	queueManagers, err := mqcloud.reportQueueManagers(ctx, options)
+	if err != nil {
+		return
+	}
+
+	report = &AmsReport{GeneratedAt: now}
+	for _, qm := range queueManagers {
+		var keyStore *KeyStoreCertificateDetailsCollection
+		keyStore, _, err = mqcloud.ListKeyStoreCertificatesWithContext(ctx, &ListKeyStoreCertificatesOptions{
+			ServiceInstanceGuid: core.StringPtr(options.ServiceInstanceGuid),
+			QueueManagerID:      qm.ID,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "key-store-list-error")
+			return nil, err
+		}
+
+		protected := make(map[string]bool)
+		for _, cert := range keyStore.KeyStore {
+			if cert.Config == nil || cert.Config.Ams == nil {
+				continue
+			}
+			for _, channel := range cert.Config.Ams.Channels {
+				if channel.Name == nil || *channel.Name == "" {
+					continue
+				}
+				entry := AmsReportEntry{
+					Channel:          *channel.Name,
+					QueueManagerID:   core.StringNilMapper(qm.ID),
+					QueueManagerName: core.StringNilMapper(qm.Name),
+					CertificateID:    core.StringNilMapper(cert.ID),
+					CertificateLabel: core.StringNilMapper(cert.Label),
+					SubjectDn:        core.StringNilMapper(cert.SubjectDn),
+					IssuerDn:         core.StringNilMapper(cert.IssuerDn),
+					Status:           AmsReportEntry_Status_Ok,
+				}
+				if cert.Expiry != nil {
+					expiry := time.Time(*cert.Expiry)
+					entry.Expiry = &expiry
+					switch {
+					case !expiry.After(now):
+						entry.Status = AmsReportEntry_Status_Expired
+					case expiry.Before(now.Add(warning)):
+						entry.Status = AmsReportEntry_Status_Expiring
+					}
+				}
+				protected[entry.Channel] = true
+				report.Entries = append(report.Entries, entry)
+			}
+		}
+		for _, channel := range options.ExpectedChannels {
+			if !protected[channel] {
+				protected[channel] = true
+				report.Entries = append(report.Entries, AmsReportEntry{
+					Channel:          channel,
+					QueueManagerID:   core.StringNilMapper(qm.ID),
+					QueueManagerName: core.StringNilMapper(qm.Name),
+					Status:           AmsReportEntry_Status_NoCertificate,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+		a, b := report.Entries[i], report.Entries[j]
+		if a.Channel != b.Channel {
+			return a.Channel < b.Channel
+		}
+		if a.QueueManagerName != b.QueueManagerName {
+			return a.QueueManagerName < b.QueueManagerName
+		}
+		return a.CertificateLabel < b.CertificateLabel
+	})
+	return
+}
+
+// reportQueueManagers returns the queue managers named in options, or every queue manager in the
+// service instance.
+func (mqcloud *MqcloudV1) reportQueueManagers(ctx context.Context, options *AmsReportOptions) (queueManagers []QueueManagerDetails, err error) {
+	if len(options.QueueManagerIDs) == 0 {
+		var pager *QueueManagersPager
+		pager, err = mqcloud.NewQueueManagersPager(&ListQueueManagersOptions{
+			ServiceInstanceGuid: core.StringPtr(options.ServiceInstanceGuid),
+		})
+		if err != nil {
+			return
+		}
+		queueManagers, err = pager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "queue-manager-list-error")
+		}
+		return
+	}
+
+	for _, id := range options.QueueManagerIDs {
+		var qm *QueueManagerDetails
+		qm, _, err = mqcloud.GetQueueManagerWithContext(ctx, &GetQueueManagerOptions{
+			ServiceInstanceGuid: core.StringPtr(options.ServiceInstanceGuid),
+			QueueManagerID:      core.StringPtr(id),
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "queue-manager-get-error")
+			return nil, err
+		}
+		queueManagers = append(queueManagers, *qm)
+	}
+	return
+}
+
+// amsReportColumns are the CSV and Markdown column headings.
+var amsReportColumns = []string{"channel", "queue_manager_name", "queue_manager_id", "certificate_label", "certificate_id", "subject_dn", "issuer_dn", "expiry", "status"}
+
+// row returns the entry's values in amsReportColumns order.
+func (entry AmsReportEntry) row() []string {
+	expiry := ""
+	if entry.Expiry != nil {
+		expiry = entry.Expiry.UTC().Format(time.RFC3339)
+	}
+	return []string{entry.Channel, entry.QueueManagerName, entry.QueueManagerID, entry.CertificateLabel, entry.CertificateID, entry.SubjectDn, entry.IssuerDn, expiry, entry.Status}
+}
+
+// WriteJSON writes the report as indented JSON.
+func (report *AmsReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// WriteCSV writes the report as CSV with a heading row.
+func (report *AmsReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(amsReportColumns); err != nil {
+		return err
+	}
+	for _, entry := range report.Entries {
+		if err := writer.Write(entry.row()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteMarkdown writes the report as a Markdown table. Flagged statuses are shown in bold.
+func (report *AmsReport) WriteMarkdown(w io.Writer) (err error) {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	line := func(cells []string) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, "| %s |\n", strings.Join(cells, " | "))
+		}
+	}
+	line(amsReportColumns)
+	separator := make([]string, len(amsReportColumns))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	line(separator)
+	for _, entry := range report.Entries {
+		cells := entry.row()
+		for i := range cells {
+			cells[i] = escape.Replace(cells[i])
+		}
+		if entry.Flagged() {
+			cells[len(cells)-1] = "**" + entry.Status + "**"
+		}
+		line(cells)
+	}
+	return
+}
@@ -0,0 +1,143 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GenerateAmsReport`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		now            = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		keyStores := map[string]string{
+			"qm1": `{"key_store":[` +
+				`{"id":"k1","label":"app","subject_dn":"CN=app","issuer_dn":"CN=ca","expiry":"2025-01-01T00:00:00.000Z","config":{"ams":{"channels":[{"name":"APP.SVRCONN"},{"name":"ADMIN.SVRCONN"}]}}},` +
+				`{"id":"k2","label":"unused","subject_dn":"CN=unused","issuer_dn":"CN=ca","expiry":"2024-06-10T00:00:00.000Z","config":{"ams":{"channels":[]}}}]}`,
+			"qm2": `{"key_store":[` +
+				`{"id":"k3","label":"soon","subject_dn":"CN=soon","issuer_dn":"CN=ca","expiry":"2024-06-10T00:00:00.000Z","config":{"ams":{"channels":[{"name":"APP.SVRCONN"}]}}}]}`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			path := strings.TrimPrefix(req.URL.Path, "/v1/"+serviceInstanceGuid+"/queue_managers")
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case path == "":
+				fmt.Fprint(res, `{"queue_managers":[{"id":"qm1","name":"QM1"},{"id":"qm2","name":"QM2"}]}`)
+			case strings.HasSuffix(path, "/certificates/key_store"):
+				fmt.Fprint(res, keyStores[strings.Split(path, "/")[1]])
+			case path == "/qm2":
+				fmt.Fprint(res, `{"id":"qm2","name":"QM2"}`)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Builds a channel-centric view with flags`, func() {
+		report, err := mqcloudService.GenerateAmsReport(&mqcloudv1.AmsReportOptions{
+			ServiceInstanceGuid: serviceInstanceGuid,
+			ExpectedChannels:    []string{"ADMIN.SVRCONN"},
+			Now:                 now,
+		})
+		Expect(err).To(BeNil())
+		Expect(report.Entries).To(HaveLen(4))
+
+		var summary []string
+		for _, entry := range report.Entries {
+			summary = append(summary, fmt.Sprintf("%s %s %s %s", entry.Channel, entry.QueueManagerName, entry.CertificateLabel, entry.Status))
+		}
+		Expect(summary).To(Equal([]string{
+			"ADMIN.SVRCONN QM1 app ok",
+			"ADMIN.SVRCONN QM2  no_certificate",
+			"APP.SVRCONN QM1 app ok",
+			"APP.SVRCONN QM2 soon expiring",
+		}))
+		Expect(report.Flagged()).To(HaveLen(2))
+		Expect(report.Entries[0].SubjectDn).To(Equal("CN=app"))
+		Expect(report.Entries[0].Expiry.Year()).To(Equal(2025))
+	})
+	It(`Reports only the requested queue managers`, func() {
+		report, err := mqcloudService.GenerateAmsReport(&mqcloudv1.AmsReportOptions{
+			ServiceInstanceGuid: serviceInstanceGuid,
+			QueueManagerIDs:     []string{"qm2"},
+			Now:                 time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		})
+		Expect(err).To(BeNil())
+		Expect(report.Entries).To(HaveLen(1))
+		Expect(report.Entries[0].Status).To(Equal(mqcloudv1.AmsReportEntry_Status_Expired))
+	})
+	It(`Writes JSON, CSV and Markdown`, func() {
+		report, err := mqcloudService.GenerateAmsReport(&mqcloudv1.AmsReportOptions{
+			ServiceInstanceGuid: serviceInstanceGuid,
+			Now:                 now,
+		})
+		Expect(err).To(BeNil())
+
+		var out bytes.Buffer
+		Expect(report.WriteJSON(&out)).To(Succeed())
+		var decoded mqcloudv1.AmsReport
+		Expect(json.Unmarshal(out.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded.Entries).To(Equal(report.Entries))
+
+		out.Reset()
+		Expect(report.WriteCSV(&out)).To(Succeed())
+		records, err := csv.NewReader(&out).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(records).To(HaveLen(4))
+		Expect(records[0][0]).To(Equal("channel"))
+		Expect(records[3]).To(ContainElement("2024-06-10T00:00:00Z"))
+
+		out.Reset()
+		Expect(report.WriteMarkdown(&out)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(5))
+		Expect(lines[1]).To(HavePrefix("| --- |"))
+		Expect(lines[4]).To(HaveSuffix("| **expiring** |"))
+	})
+	It(`Requires a service instance guid`, func() {
+		_, err := mqcloudService.GenerateAmsReport(&mqcloudv1.AmsReportOptions{})
+		Expect(err).ToNot(BeNil())
+	})
+})
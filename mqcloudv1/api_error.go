@@ -0,0 +1,231 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// APIError : An error response from the MQ on Cloud API.
+// Every error returned by an operation for an HTTP error response wraps an APIError; use errors.As to
+// get it, or the IsNotFound, IsConflict, IsRateLimited, IsInvalidState, IsQuotaExceeded and IsAuth
+// helpers to classify an error. The embedded HTTPProblem holds the operation ID and the full response.
+type APIError struct {
+	*core.HTTPProblem
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The code of the first error in the response body.
+	Code string
+
+	// The message of the first error in the response body.
+	Message string
+
+	// A link to more information about the first error.
+	MoreInfo string
+
+	// The trace ID of the failed request, from the response body or the X-Request-Id header.
+	Trace string
+
+	// All the errors in the response body.
+	Errors []APIErrorDetail
+}
+
+// APIErrorDetail : One error in an MQ on Cloud error response.
+type APIErrorDetail struct {
+	// The error code.
+	Code string `json:"code,omitempty"`
+
+	// A description of the error.
+	Message string `json:"message,omitempty"`
+
+	// A link to more information about the error.
+	MoreInfo string `json:"more_info,omitempty"`
+}
+
+// apiErrorBody is the body of an MQ on Cloud error response.
+type apiErrorBody struct {
+	Errors     []APIErrorDetail `json:"errors"`
+	Trace      string           `json:"trace"`
+	StatusCode int              `json:"status_code"`
+
+	// Some responses describe a single error at the top level.
+	Code    string `json:"code"`
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// Unwrap returns the HTTP problem, so that errors.As still finds it.
+func (e *APIError) Unwrap() []error {
+	return []error{e.HTTPProblem}
+}
+
+// newAPIError wraps the HTTP problem in err, if there is one, in an APIError.
+// It is called after core.EnrichHTTPProblem so that the operation ID is set.
+func newAPIError(err error) error {
+	var httpProblem *core.HTTPProblem
+	if !errors.As(err, &httpProblem) {
+		// A problem created by the core holds its HTTP problem in a private field, which the core
+		// only puts in the error chain when an SDK wraps the problem, and offers no accessor for.
+		errors.As(core.SDKErrorf(err, "", "", common.GetComponentInfo()), &httpProblem)
+	}
+	if httpProblem == nil || httpProblem.Response == nil {
+		return err
+	}
+	return parseAPIError(httpProblem)
+}
+
+// parseAPIError builds an APIError from the body and headers of an error response.
+func parseAPIError(httpProblem *core.HTTPProblem) *APIError {
+	apiError := &APIError{
+		HTTPProblem: httpProblem,
+		StatusCode:  httpProblem.Response.GetStatusCode(),
+	}
+
+	var body apiErrorBody
+	raw := httpProblem.Response.RawResult
+	if result, ok := httpProblem.Response.Result.(map[string]interface{}); ok {
+		raw, _ = json.Marshal(result)
+	}
+	if len(raw) > 0 && json.Unmarshal(raw, &body) == nil {
+		apiError.Errors = body.Errors
+		apiError.Trace = body.Trace
+		if len(body.Errors) == 0 && (body.Code != "" || body.Error != "" || body.Message != "") {
+			message := body.Message
+			if message == "" {
+				message = body.Error
+			}
+			apiError.Errors = []APIErrorDetail{{Code: body.Code, Message: message}}
+		}
+	}
+	if len(apiError.Errors) > 0 {
+		apiError.Code = apiError.Errors[0].Code
+		apiError.Message = apiError.Errors[0].Message
+		apiError.MoreInfo = apiError.Errors[0].MoreInfo
+	}
+	if apiError.Trace == "" && httpProblem.Response.Headers != nil {
+		apiError.Trace = httpProblem.Response.Headers.Get("X-Request-Id")
+		if apiError.Trace == "" {
+			apiError.Trace = httpProblem.Response.Headers.Get("X-Correlation-Id")
+		}
+	}
+	return apiError
+}
+
+// AsAPIError returns the APIError in err. It also builds one from an HTTP problem that did not pass
+// through an operation, such as an authentication failure.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		return apiError, true
+	}
+	var httpProblem *core.HTTPProblem
+	if errors.As(err, &httpProblem) && httpProblem.Response != nil {
+		return parseAPIError(httpProblem), true
+	}
+	return nil, false
+}
+
+// hasCode reports whether any error in the response has a code containing one of the markers.
+func (e *APIError) hasCode(markers ...string) bool {
+	for _, detail := range e.Errors {
+		code := strings.ToLower(detail.Code)
+		for _, marker := range markers {
+			if strings.Contains(code, marker) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err is a 404 response or a NotFoundError from a lookup.
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		return true
+	}
+	apiError, ok := AsAPIError(err)
+	return ok && apiError.StatusCode == http.StatusNotFound
+}
+
+// IsConflict reports whether err is a 409 response, such as for a resource that already exists or a
+// queue manager in the wrong state.
+func IsConflict(err error) bool {
+	apiError, ok := AsAPIError(err)
+	return ok && apiError.StatusCode == http.StatusConflict
+}
+
+// IsRateLimited reports whether err is a 429 response.
+func IsRateLimited(err error) bool {
+	apiError, ok := AsAPIError(err)
+	return ok && apiError.StatusCode == http.StatusTooManyRequests
+}
+
+// invalidStateCodeMarkers are the parts of the error codes that IsInvalidState takes to mean a
+// resource in the wrong state.
+var invalidStateCodeMarkers = []string{"state", "status", "busy", "not_running", "in_progress", "pending"}
+
+// quotaCodeMarkers are the parts of the error codes that IsQuotaExceeded takes to mean a used up
+// quota or entitlement.
+var quotaCodeMarkers = []string{"quota", "limit_exceeded", "entitlement", "capacity"}
+
+// IsInvalidState reports whether err is a conflict caused by the state of the resource, for example
+// a queue manager that is still deploying or is not running, rather than by a duplicate.
+// The API definition does not document its error codes, so this is a best-effort heuristic: it
+// matches 409 and 412 responses with an error code containing "state", "status", "busy",
+// "not_running", "in_progress" or "pending", which can also match unrelated codes. Use IsConflict
+// and check APIError.Code when an exact code is known.
+func IsInvalidState(err error) bool {
+	apiError, ok := AsAPIError(err)
+	if !ok || (apiError.StatusCode != http.StatusConflict && apiError.StatusCode != http.StatusPreconditionFailed) {
+		return false
+	}
+	return apiError.hasCode(invalidStateCodeMarkers...)
+}
+
+// IsQuotaExceeded reports whether err reports that an instance quota or entitlement is used up.
+// The API definition does not document its error codes, so this is a best-effort heuristic: it
+// matches 4xx responses other than 429 with an error code containing "quota", "limit_exceeded",
+// "entitlement" or "capacity", which can also match unrelated codes.
+func IsQuotaExceeded(err error) bool {
+	apiError, ok := AsAPIError(err)
+	if !ok || apiError.StatusCode < 400 || apiError.StatusCode >= 500 || apiError.StatusCode == http.StatusTooManyRequests {
+		return false
+	}
+	return apiError.hasCode(quotaCodeMarkers...)
+}
+
+// IsAuth reports whether err is a 401 or 403 response, including a failure to get an access token.
+func IsAuth(err error) bool {
+	var authError *core.AuthenticationError
+	if errors.As(err, &authError) {
+		return true
+	}
+	apiError, ok := AsAPIError(err)
+	if !ok || IsQuotaExceeded(err) {
+		return false
+	}
+	return apiError.StatusCode == http.StatusUnauthorized || apiError.StatusCode == http.StatusForbidden
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`APIError`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		status         int
+		body           string
+	)
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("X-Request-Id", "header-trace")
+			res.WriteHeader(status)
+			fmt.Fprint(res, body)
+		}))
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	// call makes a request that fails with the given response.
+	call := func(responseStatus int, responseBody string) error {
+		status, body = responseStatus, responseBody
+		_, _, err := mqcloudService.DeleteQueueManager(mqcloudService.NewDeleteQueueManagerOptions(serviceInstanceGuid, "qm1"))
+		Expect(err).ToNot(BeNil())
+		return err
+	}
+
+	It(`Parses the error body and keeps the HTTP problem`, func() {
+		err := call(409, `{"errors":[{"code":"queue_manager_busy","message":"queue manager qm1 is deploying","more_info":"https://example.com/busy"}],"trace":"trace-1","status_code":409}`)
+
+		var apiError *mqcloudv1.APIError
+		Expect(errors.As(err, &apiError)).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(409))
+		Expect(apiError.OperationID).To(Equal("delete_queue_manager"))
+		Expect(apiError.Code).To(Equal("queue_manager_busy"))
+		Expect(apiError.Message).To(Equal("queue manager qm1 is deploying"))
+		Expect(apiError.MoreInfo).To(Equal("https://example.com/busy"))
+		Expect(apiError.Trace).To(Equal("trace-1"))
+		Expect(apiError.Errors).To(HaveLen(1))
+
+		var httpProblem *core.HTTPProblem
+		Expect(errors.As(err, &httpProblem)).To(BeTrue())
+		Expect(httpProblem.Response.StatusCode).To(Equal(409))
+		Expect(err.Error()).To(Equal("queue manager qm1 is deploying"))
+	})
+	It(`Falls back to the request ID header and top-level errors`, func() {
+		err := call(500, `{"code":"internal","message":"something broke"}`)
+		apiError, ok := mqcloudv1.AsAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiError.Code).To(Equal("internal"))
+		Expect(apiError.Message).To(Equal("something broke"))
+		Expect(apiError.Trace).To(Equal("header-trace"))
+
+		err = call(502, `bad gateway`)
+		apiError, ok = mqcloudv1.AsAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiError.StatusCode).To(Equal(502))
+		Expect(apiError.Code).To(BeEmpty())
+	})
+	It(`Classifies errors`, func() {
+		notFound := call(404, `{"errors":[{"code":"queue_manager_not_found","message":"not found"}]}`)
+		duplicate := call(409, `{"errors":[{"code":"queue_manager_name_exists","message":"exists"}]}`)
+		busy := call(409, `{"errors":[{"code":"queue_manager_not_running","message":"stopped"}]}`)
+		limited := call(429, `{"errors":[{"code":"rate_limited","message":"slow down"}]}`)
+		quota := call(403, `{"errors":[{"code":"vpc_entitlement_quota_exceeded","message":"no capacity"}]}`)
+		unauthorized := call(401, `{"errors":[{"code":"unauthorized","message":"bad token"}]}`)
+
+		Expect(mqcloudv1.IsNotFound(notFound)).To(BeTrue())
+		Expect(mqcloudv1.IsNotFound(duplicate)).To(BeFalse())
+		Expect(mqcloudv1.IsNotFound(&mqcloudv1.NotFoundError{Resource: "user"})).To(BeTrue())
+
+		Expect(mqcloudv1.IsConflict(duplicate)).To(BeTrue())
+		Expect(mqcloudv1.IsConflict(busy)).To(BeTrue())
+		Expect(mqcloudv1.IsInvalidState(busy)).To(BeTrue())
+		Expect(mqcloudv1.IsInvalidState(duplicate)).To(BeFalse())
+
+		Expect(mqcloudv1.IsRateLimited(limited)).To(BeTrue())
+		Expect(mqcloudv1.IsQuotaExceeded(limited)).To(BeFalse())
+
+		Expect(mqcloudv1.IsQuotaExceeded(quota)).To(BeTrue())
+		Expect(mqcloudv1.IsAuth(quota)).To(BeFalse())
+		Expect(mqcloudv1.IsAuth(unauthorized)).To(BeTrue())
+
+		for _, err := range []error{nil, errors.New("plain")} {
+			Expect(mqcloudv1.IsNotFound(err)).To(BeFalse())
+			Expect(mqcloudv1.IsConflict(err)).To(BeFalse())
+			Expect(mqcloudv1.IsRateLimited(err)).To(BeFalse())
+			Expect(mqcloudv1.IsInvalidState(err)).To(BeFalse())
+			Expect(mqcloudv1.IsQuotaExceeded(err)).To(BeFalse())
+			Expect(mqcloudv1.IsAuth(err)).To(BeFalse())
+		}
+	})
+	It(`Classifies errors from lookups`, func() {
+		status, body = 200, `{"users":[]}`
+		_, err := mqcloudService.FindUserByEmail(mqcloudService.NewFindUserByEmailOptions(serviceInstanceGuid, "nobody@example.com"))
+		Expect(mqcloudv1.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// APIKeyMetadata : Details of an application API key that are safe to record alongside it.
+type APIKeyMetadata struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid string `json:"service_instance_guid"`
+
+	// The id of the application the key belongs to.
+	ApplicationID string `json:"application_id"`
+
+	// The id of the api key.
+	ApiKeyID string `json:"api_key_id"`
+
+	// The name of the api key.
+	ApiKeyName string `json:"api_key_name"`
+
+	// When the key was created, for tracking key age.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SecretSink : Durable storage for a newly created application API key.
+// The service only returns an API key once, so a sink must have stored the key when StoreAPIKey
+// returns without error.
+type SecretSink interface {
+	StoreAPIKey(ctx context.Context, apiKey string, metadata APIKeyMetadata) error
+}
+
+// StoredAPIKeyReporter : Optionally implemented by a SecretSink that can tell whether it holds a key.
+type StoredAPIKeyReporter interface {
+	// HasAPIKey returns true if the sink holds an api key for the application.
+	HasAPIKey(ctx context.Context, applicationID string) (bool, error)
+}
+
+// RotateApplicationApikeyOptions : The RotateApplicationApikey options.
+type RotateApplicationApikeyOptions struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid *string `json:"service_instance_guid" validate:"required,ne="`
+
+	// The id of the application.
+	ApplicationID *string `json:"application_id" validate:"required,ne="`
+
+	// The short name of the application api key - conforming to MQ rules.
+	Name *string `json:"name" validate:"required"`
+
+	// Where to store the new api key.
+	Sink SecretSink `json:"-" validate:"required"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewRotateApplicationApikeyOptions : Instantiate RotateApplicationApikeyOptions
+func (*MqcloudV1) NewRotateApplicationApikeyOptions(serviceInstanceGuid string, applicationID string, name string, sink SecretSink) *RotateApplicationApikeyOptions {
+	return &RotateApplicationApikeyOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		ApplicationID:       core.StringPtr(applicationID),
+		Name:                core.StringPtr(name),
+		Sink:                sink,
+	}
+}
+
+// RotateApplicationApikey : Create a new api key for an application and store it
+// Creates a new api key with CreateApplicationApikey and immediately hands it to the sink. If the sink
+// fails, the error is returned together with the created key so the caller can still save it; the
+// service cannot return the key again.
+func (mqcloud *MqcloudV1) RotateApplicationApikey(rotateApplicationApikeyOptions *RotateApplicationApikeyOptions) (result *ApplicationAPIKeyCreated, metadata *APIKeyMetadata, err error) {
+	result, metadata, err = mqcloud.RotateApplicationApikeyWithContext(context.Background(), rotateApplicationApikeyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RotateApplicationApikeyWithContext is an alternate form of the RotateApplicationApikey method which supports a Context parameter
+func (mqcloud *MqcloudV1) RotateApplicationApikeyWithContext(ctx context.Context, rotateApplicationApikeyOptions *RotateApplicationApikeyOptions) (result *ApplicationAPIKeyCreated, metadata *APIKeyMetadata, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "RotateApplicationApikey", QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuidOf(rotateApplicationApikeyOptions)})
+	defer func() {
+		end(err)
+	}()
+
+	err = core.ValidateNotNil(rotateApplicationApikeyOptions, "rotateApplicationApikeyOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(rotateApplicationApikeyOptions, "rotateApplicationApikeyOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	result, _, err = mqcloud.CreateApplicationApikeyWithContext(ctx, &CreateApplicationApikeyOptions{
+		ServiceInstanceGuid: rotateApplicationApikeyOptions.ServiceInstanceGuid,
+		ApplicationID:       rotateApplicationApikeyOptions.ApplicationID,
+		Name:                rotateApplicationApikeyOptions.Name,
+		Headers:             rotateApplicationApikeyOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "apikey-create-error")
+		return
+	}
+	if result == nil || result.ApiKey == nil {
+		err = core.SDKErrorf(nil, "the service did not return an api key", "missing-apikey", common.GetComponentInfo())
+		return
+	}
+
+	metadata = &APIKeyMetadata{
+		ServiceInstanceGuid: *rotateApplicationApikeyOptions.ServiceInstanceGuid,
+		ApplicationID:       *rotateApplicationApikeyOptions.ApplicationID,
+		ApiKeyID:            core.StringNilMapper(result.ApiKeyID),
+		ApiKeyName:          core.StringNilMapper(result.ApiKeyName),
+		CreatedAt:           time.Now().UTC(),
+	}
+	if metadata.ApiKeyName == "" {
+		metadata.ApiKeyName = *rotateApplicationApikeyOptions.Name
+	}
+	err = rotateApplicationApikeyOptions.Sink.StoreAPIKey(ctx, result.ApiKey.Reveal(), *metadata)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "secret-sink-error", common.GetComponentInfo())
+		return
+	}
+	return
+}
@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// recordingSink is a SecretSink that keeps what it is given.
+type recordingSink struct {
+	apiKey   string
+	metadata mqcloudv1.APIKeyMetadata
+	err      error
+}
+
+func (sink *recordingSink) StoreAPIKey(ctx context.Context, apiKey string, metadata mqcloudv1.APIKeyMetadata) error {
+	if sink.err != nil {
+		return sink.err
+	}
+	sink.apiKey = apiKey
+	sink.metadata = metadata
+	return nil
+}
+
+var _ = Describe(`RotateApplicationApikey`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+	)
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.EscapedPath()).To(Equal("/v1/" + serviceInstanceGuid + "/applications/app1/api_key"))
+			Expect(req.Method).To(Equal("POST"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(201)
+			fmt.Fprint(res, `{"api_key_name":"rotated","api_key_id":"key1","api_key":"secret-value"}`)
+		}))
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Stores the new key and its metadata in the sink`, func() {
+		sink := &recordingSink{}
+		result, metadata, err := mqcloudService.RotateApplicationApikey(mqcloudService.NewRotateApplicationApikeyOptions(serviceInstanceGuid, "app1", "rotated", sink))
+		Expect(err).To(BeNil())
+		Expect(*result.ApiKeyID).To(Equal("key1"))
+		Expect(sink.apiKey).To(Equal("secret-value"))
+		Expect(sink.metadata).To(Equal(*metadata))
+		Expect(metadata.ApiKeyID).To(Equal("key1"))
+		Expect(metadata.ApiKeyName).To(Equal("rotated"))
+		Expect(metadata.ApplicationID).To(Equal("app1"))
+		Expect(metadata.CreatedAt.IsZero()).To(BeFalse())
+	})
+	It(`Returns the key when the sink fails`, func() {
+		sink := &recordingSink{err: errors.New("disk full")}
+		result, _, err := mqcloudService.RotateApplicationApikey(mqcloudService.NewRotateApplicationApikeyOptions(serviceInstanceGuid, "app1", "rotated", sink))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("disk full"))
+		Expect(result.ApiKey.Reveal()).To(Equal("secret-value"))
+	})
+	It(`Requires a sink`, func() {
+		_, _, err := mqcloudService.RotateApplicationApikey(mqcloudService.NewRotateApplicationApikeyOptions(serviceInstanceGuid, "app1", "rotated", nil))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,39 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+// Hosts returns the distinct ConnectionDetails.Host values of every channel in the CCDT, in the order
+// they first appear.
+func (info *ConnectionInfo) Hosts() (hosts []string) {
+	if info == nil {
+		return
+	}
+	seen := make(map[string]bool)
+	for _, channel := range info.Channel {
+		if channel.ClientConnection == nil {
+			continue
+		}
+		for _, connection := range channel.ClientConnection.Connection {
+			if connection.Host == nil || *connection.Host == "" || seen[*connection.Host] {
+				continue
+			}
+			seen[*connection.Host] = true
+			hosts = append(hosts, *connection.Host)
+		}
+	}
+	return
+}
@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"sync"
+)
+
+// QueueManagerCoordinator : Runs the mutating operations on each queue manager one at a time.
+// Set it on a client with SetQueueManagerCoordinator. Operations that change a queue manager or its
+// certificates, such as SetQueueManagerVersion, CreateKeyStorePemCertificate and
+// SetCertificateAmsChannels, then wait in turn for the queue manager, while reads run freely. Clones
+// of the client share the coordinator. A QueueManagerCoordinator is safe for concurrent use.
+type QueueManagerCoordinator struct {
+	mutex  sync.Mutex
+	queues map[QueueManagerRef]*queueManagerQueue
+}
+
+// queueManagerQueue holds the turn for one queue manager.
+type queueManagerQueue struct {
+	// Holds a value while an operation has the turn.
+	turn chan struct{}
+
+	// The number of operations running or waiting.
+	depth int
+}
+
+// NewQueueManagerCoordinator returns a coordinator with no operations queued.
+func NewQueueManagerCoordinator() *QueueManagerCoordinator {
+	return &QueueManagerCoordinator{queues: make(map[QueueManagerRef]*queueManagerQueue)}
+}
+
+// acquire waits for the turn for a queue manager, or until ctx ends. The release function must be
+// called when the operation ends.
+func (coordinator *QueueManagerCoordinator) acquire(ctx context.Context, ref QueueManagerRef) (release func(), err error) {
+	coordinator.mutex.Lock()
+	queue, ok := coordinator.queues[ref]
+	if !ok {
+		queue = &queueManagerQueue{turn: make(chan struct{}, 1)}
+		coordinator.queues[ref] = queue
+	}
+	queue.depth++
+	coordinator.mutex.Unlock()
+
+	leave := func() {
+		coordinator.mutex.Lock()
+		defer coordinator.mutex.Unlock()
+		queue.depth--
+		if queue.depth == 0 {
+			delete(coordinator.queues, ref)
+		}
+	}
+
+	select {
+	case queue.turn <- struct{}{}:
+	case <-ctx.Done():
+		leave()
+		return nil, ctx.Err()
+	}
+	return func() {
+		<-queue.turn
+		leave()
+	}, nil
+}
+
+// QueueDepth returns the number of mutating operations on a queue manager that are running or
+// waiting.
+func (coordinator *QueueManagerCoordinator) QueueDepth(ref QueueManagerRef) int {
+	coordinator.mutex.Lock()
+	defer coordinator.mutex.Unlock()
+	if queue, ok := coordinator.queues[ref]; ok {
+		return queue.depth
+	}
+	return 0
+}
+
+// QueueDepths returns the number of mutating operations running or waiting for each queue manager
+// that has any.
+func (coordinator *QueueManagerCoordinator) QueueDepths() map[QueueManagerRef]int {
+	coordinator.mutex.Lock()
+	defer coordinator.mutex.Unlock()
+	depths := make(map[QueueManagerRef]int, len(coordinator.queues))
+	for ref, queue := range coordinator.queues {
+		depths[ref] = queue.depth
+	}
+	return depths
+}
+
+// SetQueueManagerCoordinator makes the client run mutating operations on each queue manager one at a
+// time through the coordinator. Nil removes it. Clones made afterwards share the coordinator.
+func (mqcloud *MqcloudV1) SetQueueManagerCoordinator(coordinator *QueueManagerCoordinator) {
+	mqcloud.coordinator = coordinator
+}
+
+// GetQueueManagerCoordinator returns the coordinator set with SetQueueManagerCoordinator, or nil.
+func (mqcloud *MqcloudV1) GetQueueManagerCoordinator() *QueueManagerCoordinator {
+	return mqcloud.coordinator
+}
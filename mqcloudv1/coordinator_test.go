@@ -0,0 +1,149 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`QueueManagerCoordinator`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		coordinator    *mqcloudv1.QueueManagerCoordinator
+		proceed        chan struct{}
+		mutex          sync.Mutex
+		running        map[string]int
+		mostRunning    map[string]int
+		total          int
+		mostTotal      int
+	)
+
+	BeforeEach(func() {
+		proceed = make(chan struct{})
+		running, mostRunning = map[string]int{}, map[string]int{}
+		total, mostTotal = 0, 0
+		// Deletes are held until the test lets them proceed; status reads answer at once.
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			id := strings.Split(strings.TrimPrefix(req.URL.Path, "/v1/"+serviceInstanceGuid+"/queue_managers/"), "/")[0]
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == http.MethodGet {
+				fmt.Fprint(res, `{"status":"running"}`)
+				return
+			}
+			mutex.Lock()
+			running[id]++
+			total++
+			if running[id] > mostRunning[id] {
+				mostRunning[id] = running[id]
+			}
+			if total > mostTotal {
+				mostTotal = total
+			}
+			mutex.Unlock()
+			<-proceed
+			mutex.Lock()
+			running[id]--
+			total--
+			mutex.Unlock()
+			res.WriteHeader(202)
+			fmt.Fprintf(res, `{"queue_manager_uri":"u","queue_manager_id":"%s"}`, id)
+		}))
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		coordinator = mqcloudv1.NewQueueManagerCoordinator()
+		mqcloudService.SetQueueManagerCoordinator(coordinator)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	ref := func(id string) mqcloudv1.QueueManagerRef {
+		return mqcloudv1.QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuid, QueueManagerID: id}
+	}
+	deleteAsync := func(client *mqcloudv1.MqcloudV1, ctx context.Context, id string) chan error {
+		done := make(chan error, 1)
+		go func() {
+			_, _, err := client.DeleteQueueManagerWithContext(ctx, client.NewDeleteQueueManagerOptions(serviceInstanceGuid, id))
+			done <- err
+		}()
+		return done
+	}
+
+	It(`Runs mutating operations on a queue manager one at a time`, func() {
+		clone := mqcloudService.Clone()
+		Expect(clone.GetQueueManagerCoordinator()).To(BeIdenticalTo(coordinator))
+		var results []chan error
+		for _, client := range []*mqcloudv1.MqcloudV1{mqcloudService, clone, mqcloudService} {
+			results = append(results, deleteAsync(client, context.Background(), "qm1"))
+		}
+		other := deleteAsync(mqcloudService, context.Background(), "qm2")
+		Eventually(func() int { return coordinator.QueueDepth(ref("qm1")) }).Should(Equal(3))
+		Eventually(func() map[mqcloudv1.QueueManagerRef]int { return coordinator.QueueDepths() }).Should(HaveKeyWithValue(ref("qm2"), 1))
+
+		// Reads are not queued behind the deletes.
+		status, _, err := mqcloudService.GetQueueManagerStatus(mqcloudService.NewGetQueueManagerStatusOptions(serviceInstanceGuid, "qm1"))
+		Expect(err).To(BeNil())
+		Expect(*status.Status).To(Equal("running"))
+
+		for i := 0; i < 4; i++ {
+			proceed <- struct{}{}
+		}
+		for _, done := range append(results, other) {
+			Expect(<-done).To(BeNil())
+		}
+		Expect(mostRunning).To(Equal(map[string]int{"qm1": 1, "qm2": 1}))
+		Expect(mostTotal).To(Equal(2))
+		Expect(coordinator.QueueDepths()).To(BeEmpty())
+	})
+	It(`Stops waiting when the context ends`, func() {
+		first := deleteAsync(mqcloudService, context.Background(), "qm1")
+		Eventually(func() int { return coordinator.QueueDepth(ref("qm1")) }).Should(Equal(1))
+		Eventually(func() int {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return running["qm1"]
+		}).Should(Equal(1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, _, err := mqcloudService.DeleteQueueManagerWithContext(ctx, mqcloudService.NewDeleteQueueManagerOptions(serviceInstanceGuid, "qm1"))
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(coordinator.QueueDepth(ref("qm1"))).To(Equal(1))
+
+		proceed <- struct{}{}
+		Expect(<-first).To(BeNil())
+		Expect(coordinator.QueueDepth(ref("qm1"))).To(Equal(0))
+	})
+})
@@ -0,0 +1,236 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// EnsureApplicationOptions : The EnsureApplication options.
+type EnsureApplicationOptions struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid *string `json:"service_instance_guid" validate:"required,ne="`
+
+	// The name of the application - conforming to MQ rules.
+	Name *string `json:"name" validate:"required,ne="`
+
+	// Where to store the api key of a newly created application, or a newly minted key.
+	Sink SecretSink `json:"-" validate:"required"`
+
+	// Mint a new api key with CreateApplicationApikey when the application exists but the sink
+	// reports it holds no key for it. The sink must implement StoredAPIKeyReporter.
+	MintKeyIfMissing *bool `json:"mint_key_if_missing,omitempty"`
+
+	// The name of a minted api key. Defaults to the application name.
+	ApiKeyName *string `json:"api_key_name,omitempty"`
+
+	// An optional cache to look the application up in.
+	Cache *LookupCache `json:"-"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewEnsureApplicationOptions : Instantiate EnsureApplicationOptions
+func (*MqcloudV1) NewEnsureApplicationOptions(serviceInstanceGuid string, name string, sink SecretSink) *EnsureApplicationOptions {
+	return &EnsureApplicationOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		Name:                core.StringPtr(name),
+		Sink:                sink,
+	}
+}
+
+// SetMintKeyIfMissing : Allow user to set MintKeyIfMissing
+func (_options *EnsureApplicationOptions) SetMintKeyIfMissing(mintKeyIfMissing bool) *EnsureApplicationOptions {
+	_options.MintKeyIfMissing = core.BoolPtr(mintKeyIfMissing)
+	return _options
+}
+
+// SetApiKeyName : Allow user to set ApiKeyName
+func (_options *EnsureApplicationOptions) SetApiKeyName(apiKeyName string) *EnsureApplicationOptions {
+	_options.ApiKeyName = core.StringPtr(apiKeyName)
+	return _options
+}
+
+// EnsureApplicationResult : The outcome of EnsureApplication.
+type EnsureApplicationResult struct {
+	// The application.
+	Application *ApplicationDetails
+
+	// True if the application was created by this call.
+	Created bool
+
+	// True if a new api key was minted for an existing application.
+	KeyMinted bool
+
+	// Metadata of the api key handed to the sink, if any.
+	Metadata *APIKeyMetadata
+
+	// Only set when the sink failed to store a new api key, so that the caller can still save it.
+	UnsavedApiKey *Secret
+}
+
+// EnsureApplication : Get or create an application, storing any new api key in a sink
+// Looks the application up by name and returns it unchanged if it exists. Otherwise creates it and
+// hands the one-time api key straight to the sink, so the key is neither printed nor lost. If another
+// caller creates the application between the lookup and the create, the create's conflict is not an
+// error: the application is looked up again and treated as one that already existed.
+func (mqcloud *MqcloudV1) EnsureApplication(ensureApplicationOptions *EnsureApplicationOptions) (result *EnsureApplicationResult, err error) {
+	result, err = mqcloud.EnsureApplicationWithContext(context.Background(), ensureApplicationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnsureApplicationWithContext is an alternate form of the EnsureApplication method which supports a Context parameter
+func (mqcloud *MqcloudV1) EnsureApplicationWithContext(ctx context.Context, ensureApplicationOptions *EnsureApplicationOptions) (result *EnsureApplicationResult, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "EnsureApplication", QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuidOf(ensureApplicationOptions)})
+	defer func() {
+		end(err)
+	}()
+
+	err = core.ValidateNotNil(ensureApplicationOptions, "ensureApplicationOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(ensureApplicationOptions, "ensureApplicationOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	mint := ensureApplicationOptions.MintKeyIfMissing != nil && *ensureApplicationOptions.MintKeyIfMissing
+	reporter, canReport := ensureApplicationOptions.Sink.(StoredAPIKeyReporter)
+	if mint && !canReport {
+		err = core.SDKErrorf(nil, "MintKeyIfMissing requires a sink that implements StoredAPIKeyReporter", "sink-cannot-report", common.GetComponentInfo())
+		return
+	}
+
+	application, err := mqcloud.FindApplicationByNameWithContext(ctx, &FindApplicationByNameOptions{
+		ServiceInstanceGuid: ensureApplicationOptions.ServiceInstanceGuid,
+		Name:                ensureApplicationOptions.Name,
+		Cache:               ensureApplicationOptions.Cache,
+		Headers:             ensureApplicationOptions.Headers,
+	})
+	var notFound *NotFoundError
+	if err != nil && !errors.As(err, &notFound) {
+		err = core.RepurposeSDKProblem(err, "application-lookup-error")
+		return
+	}
+
+	if err == nil {
+		return mqcloud.ensureExistingApplication(ctx, ensureApplicationOptions, application, mint, reporter)
+	}
+
+	created, _, err := mqcloud.CreateApplicationWithContext(ctx, &CreateApplicationOptions{
+		ServiceInstanceGuid: ensureApplicationOptions.ServiceInstanceGuid,
+		Name:                ensureApplicationOptions.Name,
+		Headers:             ensureApplicationOptions.Headers,
+	})
+	if ensureApplicationOptions.Cache != nil {
+		ensureApplicationOptions.Cache.Invalidate()
+	}
+	if IsConflict(err) {
+		// Another caller created the application after the lookup above; carry on with theirs.
+		application, err = mqcloud.FindApplicationByNameWithContext(ctx, &FindApplicationByNameOptions{
+			ServiceInstanceGuid: ensureApplicationOptions.ServiceInstanceGuid,
+			Name:                ensureApplicationOptions.Name,
+			Cache:               ensureApplicationOptions.Cache,
+			Headers:             ensureApplicationOptions.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "application-lookup-error")
+			return
+		}
+		return mqcloud.ensureExistingApplication(ctx, ensureApplicationOptions, application, mint, reporter)
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "application-create-error")
+		return
+	}
+	result = &EnsureApplicationResult{
+		Application: &ApplicationDetails{
+			ID:              created.ID,
+			Name:            created.Name,
+			CreateApiKeyURI: created.CreateApiKeyURI,
+			Href:            created.Href,
+		},
+		Created: true,
+		Metadata: &APIKeyMetadata{
+			ServiceInstanceGuid: *ensureApplicationOptions.ServiceInstanceGuid,
+			ApplicationID:       core.StringNilMapper(created.ID),
+			ApiKeyID:            core.StringNilMapper(created.ApiKeyID),
+			ApiKeyName:          core.StringNilMapper(created.ApiKeyName),
+			CreatedAt:           time.Now().UTC(),
+		},
+	}
+	if created.ApiKey == nil {
+		err = core.SDKErrorf(nil, "the service did not return an api key", "missing-apikey", common.GetComponentInfo())
+		return
+	}
+	err = ensureApplicationOptions.Sink.StoreAPIKey(ctx, created.ApiKey.Reveal(), *result.Metadata)
+	if err != nil {
+		result.UnsavedApiKey = created.ApiKey
+		err = core.SDKErrorf(err, "", "secret-sink-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// ensureExistingApplication returns the result for an application that exists, minting a new api key
+// for it if MintKeyIfMissing is set and the sink holds none.
+func (mqcloud *MqcloudV1) ensureExistingApplication(ctx context.Context, ensureApplicationOptions *EnsureApplicationOptions,
+	application *ApplicationDetails, mint bool, reporter StoredAPIKeyReporter) (result *EnsureApplicationResult, err error) {
+	result = &EnsureApplicationResult{Application: application}
+	if !mint {
+		return
+	}
+	var stored bool
+	stored, err = reporter.HasAPIKey(ctx, core.StringNilMapper(application.ID))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "secret-sink-error", common.GetComponentInfo())
+		return nil, err
+	}
+	if stored {
+		return
+	}
+	apiKeyName := ensureApplicationOptions.ApiKeyName
+	if apiKeyName == nil {
+		apiKeyName = ensureApplicationOptions.Name
+	}
+	var created *ApplicationAPIKeyCreated
+	created, result.Metadata, err = mqcloud.RotateApplicationApikeyWithContext(ctx, &RotateApplicationApikeyOptions{
+		ServiceInstanceGuid: ensureApplicationOptions.ServiceInstanceGuid,
+		ApplicationID:       application.ID,
+		Name:                apiKeyName,
+		Sink:                ensureApplicationOptions.Sink,
+		Headers:             ensureApplicationOptions.Headers,
+	})
+	if created != nil {
+		result.KeyMinted = true
+		if err != nil {
+			result.UnsavedApiKey = created.ApiKey
+		}
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "apikey-mint-error")
+	}
+	return
+}
@@ -0,0 +1,198 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// reportingSink is a recordingSink that also reports whether it holds a key.
+type reportingSink struct {
+	recordingSink
+	stored map[string]bool
+}
+
+func (sink *reportingSink) StoreAPIKey(ctx context.Context, apiKey string, metadata mqcloudv1.APIKeyMetadata) error {
+	if err := sink.recordingSink.StoreAPIKey(ctx, apiKey, metadata); err != nil {
+		return err
+	}
+	sink.stored[metadata.ApplicationID] = true
+	return nil
+}
+
+func (sink *reportingSink) HasAPIKey(ctx context.Context, applicationID string) (bool, error) {
+	return sink.stored[applicationID], nil
+}
+
+var _ = Describe(`EnsureApplication`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		applications   []map[string]string
+		creates        int
+		keys           int
+	)
+
+	BeforeEach(func() {
+		applications = []map[string]string{{"id": "a1", "name": "orders"}}
+		creates, keys = 0, 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			path := strings.TrimPrefix(req.URL.Path, "/v1/"+serviceInstanceGuid+"/applications")
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && path == "":
+				body, _ := json.Marshal(map[string]interface{}{"applications": applications})
+				_, _ = res.Write(body)
+			case req.Method == "POST" && path == "":
+				var body map[string]string
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				creates++
+				id := fmt.Sprintf("new%d", creates)
+				applications = append(applications, map[string]string{"id": id, "name": body["name"]})
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id":"%s","name":"%s","create_api_key_uri":"u","href":"h","api_key_id":"k-%s","api_key_name":"%s","api_key":"created-key"}`, id, body["name"], id, body["name"])
+			case req.Method == "POST" && strings.HasSuffix(path, "/api_key"):
+				var body map[string]string
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				keys++
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"api_key_id":"minted%d","api_key_name":"%s","api_key":"minted-key"}`, keys, body["name"])
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Returns an existing application unchanged`, func() {
+		sink := &recordingSink{}
+		result, err := mqcloudService.EnsureApplication(mqcloudService.NewEnsureApplicationOptions(serviceInstanceGuid, "orders", sink))
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeFalse())
+		Expect(*result.Application.ID).To(Equal("a1"))
+		Expect(result.Metadata).To(BeNil())
+		Expect(sink.apiKey).To(BeEmpty())
+		Expect(creates).To(Equal(0))
+	})
+	It(`Creates a missing application and stores its key`, func() {
+		sink := &recordingSink{}
+		result, err := mqcloudService.EnsureApplication(mqcloudService.NewEnsureApplicationOptions(serviceInstanceGuid, "payments", sink))
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeTrue())
+		Expect(*result.Application.ID).To(Equal("new1"))
+		Expect(result.UnsavedApiKey).To(BeNil())
+		Expect(sink.apiKey).To(Equal("created-key"))
+		Expect(sink.metadata.ApiKeyID).To(Equal("k-new1"))
+		Expect(sink.metadata.ApplicationID).To(Equal("new1"))
+
+		result, err = mqcloudService.EnsureApplication(mqcloudService.NewEnsureApplicationOptions(serviceInstanceGuid, "payments", sink))
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeFalse())
+		Expect(creates).To(Equal(1))
+	})
+	It(`Returns the key when the sink fails`, func() {
+		sink := &recordingSink{err: errors.New("vault sealed")}
+		result, err := mqcloudService.EnsureApplication(mqcloudService.NewEnsureApplicationOptions(serviceInstanceGuid, "payments", sink))
+		Expect(err).ToNot(BeNil())
+		Expect(result.Created).To(BeTrue())
+		Expect(result.UnsavedApiKey.Reveal()).To(Equal("created-key"))
+	})
+	It(`Mints a key when the sink has none`, func() {
+		sink := &reportingSink{stored: map[string]bool{}}
+		options := mqcloudService.NewEnsureApplicationOptions(serviceInstanceGuid, "orders", sink).SetMintKeyIfMissing(true).SetApiKeyName("ordkey")
+		result, err := mqcloudService.EnsureApplication(options)
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeFalse())
+		Expect(result.KeyMinted).To(BeTrue())
+		Expect(result.Metadata.ApiKeyName).To(Equal("ordkey"))
+		Expect(sink.apiKey).To(Equal("minted-key"))
+
+		result, err = mqcloudService.EnsureApplication(options)
+		Expect(err).To(BeNil())
+		Expect(result.KeyMinted).To(BeFalse())
+		Expect(keys).To(Equal(1))
+	})
+	It(`Uses an application created by another caller after the lookup`, func() {
+		emulator := mqcloudtest.NewServer(nil)
+		emulator.AddServiceInstance(serviceInstanceGuid)
+		var raced []string
+		raceServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if req.Method == "POST" && req.URL.Path == "/v1/"+serviceInstanceGuid+"/applications" {
+				// Another caller creates the same application first.
+				body, _ := io.ReadAll(req.Body)
+				other := httptest.NewRecorder()
+				emulator.ServeHTTP(other, httptest.NewRequest("POST", req.URL.String(), bytes.NewReader(body)))
+				raced = append(raced, other.Body.String())
+				req.Body = io.NopCloser(bytes.NewReader(body))
+			}
+			emulator.ServeHTTP(res, req)
+		}))
+		defer raceServer.Close()
+		Expect(mqcloudService.SetServiceURL(raceServer.URL)).To(Succeed())
+
+		sink := &recordingSink{}
+		result, err := mqcloudService.EnsureApplication(mqcloudService.NewEnsureApplicationOptions(serviceInstanceGuid, "billing", sink))
+		Expect(err).To(BeNil())
+		Expect(raced).To(HaveLen(1))
+		Expect(raced[0]).To(ContainSubstring(*result.Application.ID))
+		Expect(result.Created).To(BeFalse())
+		Expect(result.KeyMinted).To(BeFalse())
+		Expect(sink.apiKey).To(BeEmpty())
+
+		minting := &reportingSink{stored: map[string]bool{}}
+		cache := mqcloudv1.NewLookupCache(time.Minute)
+		options := mqcloudService.NewEnsureApplicationOptions(serviceInstanceGuid, "audit", minting).SetMintKeyIfMissing(true)
+		options.Cache = cache
+		result, err = mqcloudService.EnsureApplication(options)
+		Expect(err).To(BeNil())
+		Expect(raced).To(HaveLen(2))
+		Expect(result.Created).To(BeFalse())
+		Expect(result.KeyMinted).To(BeTrue())
+		Expect(minting.apiKey).ToNot(BeEmpty())
+		Expect(minting.metadata.ApplicationID).To(Equal(*result.Application.ID))
+	})
+	It(`Requires a reporting sink to mint keys`, func() {
+		_, err := mqcloudService.EnsureApplication(mqcloudService.NewEnsureApplicationOptions(serviceInstanceGuid, "orders", &recordingSink{}).SetMintKeyIfMissing(true))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,224 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// CertificateHostnameCheck : How one certificate's names cover a queue manager's connection hosts.
+type CertificateHostnameCheck struct {
+	// The id of the key store certificate. Empty for a certificate that has not been uploaded.
+	CertificateID string
+
+	// Certificate label in queue manager store.
+	Label string
+
+	// Indicates whether it is the queue manager's default certificate.
+	IsDefault bool
+
+	// The names the certificate is verified against: its DNS names, or its subject common name
+	// when it has no DNS names.
+	Names []string
+
+	// Connection hosts that match one of the names.
+	MatchedHosts []string
+
+	// Connection hosts that match none of the names, and so would fail hostname verification.
+	UnmatchedHosts []string
+}
+
+// Ok returns true if every connection host matches one of the certificate's names.
+func (check CertificateHostnameCheck) Ok() bool {
+	return len(check.UnmatchedHosts) == 0
+}
+
+// HostnameReport : The outcome of checking a queue manager's key store certificates against its connection hosts.
+type HostnameReport struct {
+	// The queue manager that was checked.
+	QueueManager QueueManagerRef
+
+	// The connection hosts from the queue manager's connection information.
+	Hosts []string
+
+	// One entry per key store certificate, in key store order.
+	Certificates []CertificateHostnameCheck
+}
+
+// DefaultFailures returns the checks of default certificates that would fail hostname verification
+// for at least one connection host. Clients are presented with the default certificate, so these are
+// the mismatches that break connections.
+func (report *HostnameReport) DefaultFailures() (failures []CertificateHostnameCheck) {
+	for _, check := range report.Certificates {
+		if check.IsDefault && !check.Ok() {
+			failures = append(failures, check)
+		}
+	}
+	return
+}
+
+// CheckKeyStoreHostnames : Check key store certificates against the queue manager's connection hosts
+// Cross-checks the DNS names (or subject common name) of every key store certificate of the queue
+// manager against every host in its connection information, wildcards included.
+func (mqcloud *MqcloudV1) CheckKeyStoreHostnames(qm QueueManagerRef) (report *HostnameReport, err error) {
+	report, err = mqcloud.CheckKeyStoreHostnamesWithContext(context.Background(), qm)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CheckKeyStoreHostnamesWithContext is an alternate form of the CheckKeyStoreHostnames method which supports a Context parameter
+func (mqcloud *MqcloudV1) CheckKeyStoreHostnamesWithContext(ctx context.Context, qm QueueManagerRef) (report *HostnameReport, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "CheckKeyStoreHostnames", qm)
+	defer func() {
+		end(err)
+	}()
+
+	if qm.ServiceInstanceGuid == "" || qm.QueueManagerID == "" {
+		err = core.SDKErrorf(nil, "queue manager references must have a service instance guid and a queue manager id", "invalid-queue-manager-ref", common.GetComponentInfo())
+		return
+	}
+
+	info, _, err := mqcloud.GetQueueManagerConnectionInfoWithContext(ctx, &GetQueueManagerConnectionInfoOptions{
+		ServiceInstanceGuid: core.StringPtr(qm.ServiceInstanceGuid),
+		QueueManagerID:      core.StringPtr(qm.QueueManagerID),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "connection-info-error")
+		return
+	}
+	keyStore, _, err := mqcloud.ListKeyStoreCertificatesWithContext(ctx, &ListKeyStoreCertificatesOptions{
+		ServiceInstanceGuid: core.StringPtr(qm.ServiceInstanceGuid),
+		QueueManagerID:      core.StringPtr(qm.QueueManagerID),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "key-store-list-error")
+		return
+	}
+
+	report = &HostnameReport{
+		QueueManager: qm,
+		Hosts:        info.Hosts(),
+	}
+	for i := range keyStore.KeyStore {
+		cert := &keyStore.KeyStore[i]
+		// The list may truncate the DNS names of certificates that have many of them.
+		if cert.DnsNamesTotalCount != nil && int(*cert.DnsNamesTotalCount) > len(cert.DnsNames) && cert.ID != nil {
+			cert, _, err = mqcloud.GetKeyStoreCertificateWithContext(ctx, &GetKeyStoreCertificateOptions{
+				ServiceInstanceGuid: core.StringPtr(qm.ServiceInstanceGuid),
+				QueueManagerID:      core.StringPtr(qm.QueueManagerID),
+				CertificateID:       cert.ID,
+			})
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "key-store-get-error")
+				return nil, err
+			}
+		}
+		check := checkHostnames(certificateNames(cert.DnsNames, core.StringNilMapper(cert.SubjectCn)), report.Hosts)
+		check.CertificateID = core.StringNilMapper(cert.ID)
+		check.Label = core.StringNilMapper(cert.Label)
+		check.IsDefault = cert.IsDefault != nil && *cert.IsDefault
+		report.Certificates = append(report.Certificates, check)
+	}
+	return
+}
+
+// CheckCertificateHostnames checks a PEM encoded certificate, such as one about to be uploaded with
+// CreateKeyStorePemCertificate, against the hosts in a queue manager's connection information. The
+// first certificate in the data is taken to be the server certificate; private keys and the rest of
+// the chain are ignored.
+func CheckCertificateHostnames(certificatePEM []byte, info *ConnectionInfo) (check *CertificateHostnameCheck, err error) {
+	certs, err := parsePEMCertificates(certificatePEM)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "certificate-parse-error", common.GetComponentInfo())
+		return
+	}
+	leaf := certs[0]
+	names := append([]string{}, leaf.DNSNames...)
+	for _, ip := range leaf.IPAddresses {
+		names = append(names, ip.String())
+	}
+	result := checkHostnames(certificateNames(names, leaf.Subject.CommonName), info.Hosts())
+	check = &result
+	return
+}
+
+// HostnameMatches reports whether a certificate name, which may have a wildcard as its leftmost
+// label (for example "*.example.com"), matches host. Matching is case insensitive and ignores a
+// trailing dot. A wildcard matches exactly one non-empty label, so "*.example.com" matches
+// "qm1.example.com" but not "example.com" or "a.qm1.example.com".
+func HostnameMatches(name string, host string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if name == "" || host == "" {
+		return false
+	}
+	if net.ParseIP(host) != nil {
+		ip := net.ParseIP(name)
+		return ip != nil && ip.Equal(net.ParseIP(host))
+	}
+	if !strings.HasPrefix(name, "*.") {
+		return name == host
+	}
+	label, rest, found := strings.Cut(host, ".")
+	return found && label != "" && rest == name[2:] && strings.Contains(rest, ".")
+}
+
+// certificateNames returns the names a client verifies the certificate against. Clients only fall
+// back to the subject common name when the certificate has no subject alternative names.
+func certificateNames(dnsNames []string, subjectCn string) []string {
+	if len(dnsNames) > 0 {
+		return dnsNames
+	}
+	if subjectCn != "" {
+		return []string{subjectCn}
+	}
+	return nil
+}
+
+// checkHostnames matches every host against names.
+func checkHostnames(names []string, hosts []string) (check CertificateHostnameCheck) {
+	check.Names = names
+	for _, host := range hosts {
+		matched := false
+		for _, name := range names {
+			if HostnameMatches(name, host) {
+				matched = true
+				break
+			}
+		}
+		if matched {
+			check.MatchedHosts = append(check.MatchedHosts, host)
+		} else {
+			check.UnmatchedHosts = append(check.UnmatchedHosts, host)
+		}
+	}
+	return
+}
+
+// String summarises the check, naming any hosts that would fail verification.
+func (check CertificateHostnameCheck) String() string {
+	if check.Ok() {
+		return fmt.Sprintf("certificate %q covers all %d connection hosts", check.Label, len(check.MatchedHosts))
+	}
+	return fmt.Sprintf("certificate %q does not cover %s", check.Label, strings.Join(check.UnmatchedHosts, ", "))
+}
@@ -0,0 +1,133 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Hostname checks`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	const connectionInfo = `{"channel":[{"name":"CLOUD.APP.SVRCONN","type":"clientConnection","clientConnection":{"connection":[{"host":"qm1-abcd.qm.eu-de.mq.appdomain.cloud","port":31175},{"host":"qm1.internal.example.com","port":31175}],"queueManager":"qm1"},"transmissionSecurity":{"cipherSpecification":"ANY_TLS12_OR_HIGHER"}}]}`
+
+	Describe(`HostnameMatches`, func() {
+		It(`Matches exact names case insensitively`, func() {
+			Expect(mqcloudv1.HostnameMatches("QM1.example.com", "qm1.example.com.")).To(BeTrue())
+			Expect(mqcloudv1.HostnameMatches("qm2.example.com", "qm1.example.com")).To(BeFalse())
+		})
+		It(`Matches a wildcard against exactly one label`, func() {
+			Expect(mqcloudv1.HostnameMatches("*.example.com", "qm1.example.com")).To(BeTrue())
+			Expect(mqcloudv1.HostnameMatches("*.example.com", "example.com")).To(BeFalse())
+			Expect(mqcloudv1.HostnameMatches("*.example.com", "a.qm1.example.com")).To(BeFalse())
+			Expect(mqcloudv1.HostnameMatches("*.com", "example.com")).To(BeFalse())
+			Expect(mqcloudv1.HostnameMatches("qm*.example.com", "qm1.example.com")).To(BeFalse())
+		})
+		It(`Matches IP addresses literally`, func() {
+			Expect(mqcloudv1.HostnameMatches("10.0.0.1", "10.0.0.1")).To(BeTrue())
+			Expect(mqcloudv1.HostnameMatches("*.0.0.1", "10.0.0.1")).To(BeFalse())
+		})
+	})
+
+	Describe(`CheckKeyStoreHostnames`, func() {
+		var (
+			testServer     *httptest.Server
+			mqcloudService *mqcloudv1.MqcloudV1
+			getCalls       int
+		)
+
+		BeforeEach(func() {
+			getCalls = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				path := strings.TrimPrefix(req.URL.Path, "/v1/"+serviceInstanceGuid+"/queue_managers/qm1/")
+				res.Header().Set("Content-type", "application/json")
+				switch path {
+				case "connection_info":
+					fmt.Fprint(res, connectionInfo)
+				case "certificates/key_store":
+					fmt.Fprint(res, `{"total_count":3,"key_store":[`+
+						`{"id":"wild","label":"wildcard","is_default":true,"subject_cn":"qm1","dns_names_total_count":1,"dns_names":["*.qm.eu-de.mq.appdomain.cloud"]},`+
+						`{"id":"cn","label":"cn_only","is_default":false,"subject_cn":"qm1.internal.example.com","dns_names_total_count":0,"dns_names":[]},`+
+						`{"id":"many","label":"many","is_default":false,"subject_cn":"qm1","dns_names_total_count":2,"dns_names":["*.qm.eu-de.mq.appdomain.cloud"]}]}`)
+				case "certificates/key_store/many":
+					getCalls++
+					fmt.Fprint(res, `{"id":"many","label":"many","is_default":false,"subject_cn":"qm1","dns_names_total_count":2,"dns_names":["*.qm.eu-de.mq.appdomain.cloud","qm1.internal.example.com"]}`)
+				default:
+					res.WriteHeader(404)
+				}
+			}))
+
+			var err error
+			mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Flags default certificates that do not cover every host`, func() {
+			report, err := mqcloudService.CheckKeyStoreHostnames(mqcloudv1.QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuid, QueueManagerID: "qm1"})
+			Expect(err).To(BeNil())
+			Expect(report.Hosts).To(Equal([]string{"qm1-abcd.qm.eu-de.mq.appdomain.cloud", "qm1.internal.example.com"}))
+			Expect(report.Certificates).To(HaveLen(3))
+
+			Expect(report.Certificates[0].MatchedHosts).To(Equal([]string{"qm1-abcd.qm.eu-de.mq.appdomain.cloud"}))
+			Expect(report.Certificates[0].UnmatchedHosts).To(Equal([]string{"qm1.internal.example.com"}))
+			Expect(report.Certificates[1].Names).To(Equal([]string{"qm1.internal.example.com"}))
+			Expect(report.Certificates[1].UnmatchedHosts).To(Equal([]string{"qm1-abcd.qm.eu-de.mq.appdomain.cloud"}))
+			Expect(report.Certificates[2].Ok()).To(BeTrue())
+			Expect(getCalls).To(Equal(1))
+
+			failures := report.DefaultFailures()
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0].CertificateID).To(Equal("wild"))
+			Expect(failures[0].String()).To(ContainSubstring("qm1.internal.example.com"))
+		})
+	})
+
+	Describe(`CheckCertificateHostnames`, func() {
+		It(`Checks a certificate before it is uploaded`, func() {
+			info := &mqcloudv1.ConnectionInfo{}
+			Expect(json.Unmarshal([]byte(connectionInfo), info)).To(Succeed())
+
+			certPEM, _ := newTestCertificate("qm1", "*.qm.eu-de.mq.appdomain.cloud", "qm1.internal.example.com")
+			check, err := mqcloudv1.CheckCertificateHostnames(certPEM, info)
+			Expect(err).To(BeNil())
+			Expect(check.Ok()).To(BeTrue())
+
+			certPEM, _ = newTestCertificate("qm1.internal.example.com")
+			check, err = mqcloudv1.CheckCertificateHostnames(certPEM, info)
+			Expect(err).To(BeNil())
+			Expect(check.UnmatchedHosts).To(Equal([]string{"qm1-abcd.qm.eu-de.mq.appdomain.cloud"}))
+
+			_, err = mqcloudv1.CheckCertificateHostnames([]byte("junk"), info)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
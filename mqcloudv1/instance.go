@@ -0,0 +1,972 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// ServiceInstance : A client bound to one MQ on Cloud service instance.
+// Its methods take the same options types as MqcloudV1 and fill in ServiceInstanceGuid, so calls
+// cannot be sent to the wrong instance by mistake. Options are copied, never modified, and a nil
+// options is treated as empty. Options that already name a different service instance are rejected.
+// A ServiceInstance is immutable and safe for concurrent use if the underlying client is.
+type ServiceInstance struct {
+	client *MqcloudV1
+	guid   string
+}
+
+// Instance returns a handle for the service instance with the given GUID. The handle shares this
+// client, including its authenticator and settings.
+func (mqcloud *MqcloudV1) Instance(serviceInstanceGuid string) *ServiceInstance {
+	return &ServiceInstance{
+		client: mqcloud,
+		guid:   serviceInstanceGuid,
+	}
+}
+
+// GUID returns the GUID of the service instance.
+func (instance *ServiceInstance) GUID() string {
+	return instance.guid
+}
+
+// Client returns the client the handle makes its calls with.
+func (instance *ServiceInstance) Client() *MqcloudV1 {
+	return instance.client
+}
+
+// bind returns the instance GUID to use in place of the GUID already set in an options struct.
+func (instance *ServiceInstance) bind(serviceInstanceGuid *string) (*string, error) {
+	return bindIdentifier("service instance", instance.guid, serviceInstanceGuid)
+}
+
+// bindIdentifier returns the identifier a handle was created for, failing if the handle has none or
+// if the options already hold a different one.
+func bindIdentifier(resource string, identifier string, current *string) (*string, error) {
+	code := strings.ReplaceAll(resource, " ", "-")
+	if identifier == "" {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the %s handle has no identifier", resource), "missing-"+code+"-id", common.GetComponentInfo())
+	}
+	if current != nil && *current != "" && *current != identifier {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("options are for %s %s, not %s", resource, *current, identifier), code+"-mismatch", common.GetComponentInfo())
+	}
+	return core.StringPtr(identifier), nil
+}
+
+// NewQueueManagersPager returns a new QueueManagersPager instance for the service instance.
+func (instance *ServiceInstance) NewQueueManagersPager(options *ListQueueManagersOptions) (pager *QueueManagersPager, err error) {
+	optionsCopy := ListQueueManagersOptions{}
+	if options != nil {
+		optionsCopy = *options
+	}
+	optionsCopy.ServiceInstanceGuid, err = instance.bind(optionsCopy.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.NewQueueManagersPager(&optionsCopy)
+}
+
+// NewUsersPager returns a new UsersPager instance for the service instance.
+func (instance *ServiceInstance) NewUsersPager(options *ListUsersOptions) (pager *UsersPager, err error) {
+	optionsCopy := ListUsersOptions{}
+	if options != nil {
+		optionsCopy = *options
+	}
+	optionsCopy.ServiceInstanceGuid, err = instance.bind(optionsCopy.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.NewUsersPager(&optionsCopy)
+}
+
+// NewApplicationsPager returns a new ApplicationsPager instance for the service instance.
+func (instance *ServiceInstance) NewApplicationsPager(options *ListApplicationsOptions) (pager *ApplicationsPager, err error) {
+	optionsCopy := ListApplicationsOptions{}
+	if options != nil {
+		optionsCopy = *options
+	}
+	optionsCopy.ServiceInstanceGuid, err = instance.bind(optionsCopy.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.NewApplicationsPager(&optionsCopy)
+}
+
+// GetUsageDetails : Get the usage details
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetUsageDetails(getUsageDetailsOptions *GetUsageDetailsOptions) (result *Usage, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetUsageDetailsWithContext(context.Background(), getUsageDetailsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetUsageDetailsWithContext is an alternate form of the GetUsageDetails method which supports a Context parameter
+func (instance *ServiceInstance) GetUsageDetailsWithContext(ctx context.Context, getUsageDetailsOptions *GetUsageDetailsOptions) (result *Usage, response *core.DetailedResponse, err error) {
+	bound := &GetUsageDetailsOptions{}
+	if getUsageDetailsOptions != nil {
+		optionsCopy := *getUsageDetailsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetUsageDetailsWithContext(ctx, bound)
+}
+
+// GetOptions : Return configuration options (eg, available deployment locations, queue manager sizes)
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetOptions(getOptionsOptions *GetOptionsOptions) (result *ConfigurationOptions, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetOptionsWithContext(context.Background(), getOptionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetOptionsWithContext is an alternate form of the GetOptions method which supports a Context parameter
+func (instance *ServiceInstance) GetOptionsWithContext(ctx context.Context, getOptionsOptions *GetOptionsOptions) (result *ConfigurationOptions, response *core.DetailedResponse, err error) {
+	bound := &GetOptionsOptions{}
+	if getOptionsOptions != nil {
+		optionsCopy := *getOptionsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetOptionsWithContext(ctx, bound)
+}
+
+// CreateQueueManager : Create a new queue manager
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) CreateQueueManager(createQueueManagerOptions *CreateQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	result, response, err = instance.CreateQueueManagerWithContext(context.Background(), createQueueManagerOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateQueueManagerWithContext is an alternate form of the CreateQueueManager method which supports a Context parameter
+func (instance *ServiceInstance) CreateQueueManagerWithContext(ctx context.Context, createQueueManagerOptions *CreateQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	bound := &CreateQueueManagerOptions{}
+	if createQueueManagerOptions != nil {
+		optionsCopy := *createQueueManagerOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.CreateQueueManagerWithContext(ctx, bound)
+}
+
+// ListQueueManagers : Get list of queue managers
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) ListQueueManagers(listQueueManagersOptions *ListQueueManagersOptions) (result *QueueManagerDetailsCollection, response *core.DetailedResponse, err error) {
+	result, response, err = instance.ListQueueManagersWithContext(context.Background(), listQueueManagersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListQueueManagersWithContext is an alternate form of the ListQueueManagers method which supports a Context parameter
+func (instance *ServiceInstance) ListQueueManagersWithContext(ctx context.Context, listQueueManagersOptions *ListQueueManagersOptions) (result *QueueManagerDetailsCollection, response *core.DetailedResponse, err error) {
+	bound := &ListQueueManagersOptions{}
+	if listQueueManagersOptions != nil {
+		optionsCopy := *listQueueManagersOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.ListQueueManagersWithContext(ctx, bound)
+}
+
+// GetQueueManager : Get details of a queue manager
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetQueueManager(getQueueManagerOptions *GetQueueManagerOptions) (result *QueueManagerDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetQueueManagerWithContext(context.Background(), getQueueManagerOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetQueueManagerWithContext is an alternate form of the GetQueueManager method which supports a Context parameter
+func (instance *ServiceInstance) GetQueueManagerWithContext(ctx context.Context, getQueueManagerOptions *GetQueueManagerOptions) (result *QueueManagerDetails, response *core.DetailedResponse, err error) {
+	bound := &GetQueueManagerOptions{}
+	if getQueueManagerOptions != nil {
+		optionsCopy := *getQueueManagerOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetQueueManagerWithContext(ctx, bound)
+}
+
+// DeleteQueueManager : Delete a queue manager
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) DeleteQueueManager(deleteQueueManagerOptions *DeleteQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	result, response, err = instance.DeleteQueueManagerWithContext(context.Background(), deleteQueueManagerOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteQueueManagerWithContext is an alternate form of the DeleteQueueManager method which supports a Context parameter
+func (instance *ServiceInstance) DeleteQueueManagerWithContext(ctx context.Context, deleteQueueManagerOptions *DeleteQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	bound := &DeleteQueueManagerOptions{}
+	if deleteQueueManagerOptions != nil {
+		optionsCopy := *deleteQueueManagerOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.DeleteQueueManagerWithContext(ctx, bound)
+}
+
+// SetQueueManagerVersion : Upgrade a queue manager
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) SetQueueManagerVersion(setQueueManagerVersionOptions *SetQueueManagerVersionOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	result, response, err = instance.SetQueueManagerVersionWithContext(context.Background(), setQueueManagerVersionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetQueueManagerVersionWithContext is an alternate form of the SetQueueManagerVersion method which supports a Context parameter
+func (instance *ServiceInstance) SetQueueManagerVersionWithContext(ctx context.Context, setQueueManagerVersionOptions *SetQueueManagerVersionOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	bound := &SetQueueManagerVersionOptions{}
+	if setQueueManagerVersionOptions != nil {
+		optionsCopy := *setQueueManagerVersionOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.SetQueueManagerVersionWithContext(ctx, bound)
+}
+
+// GetQueueManagerAvailableUpgradeVersions : Get the list of available versions that this queue manager can be upgraded to
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetQueueManagerAvailableUpgradeVersions(getQueueManagerAvailableUpgradeVersionsOptions *GetQueueManagerAvailableUpgradeVersionsOptions) (result *QueueManagerVersionUpgrades, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetQueueManagerAvailableUpgradeVersionsWithContext(context.Background(), getQueueManagerAvailableUpgradeVersionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetQueueManagerAvailableUpgradeVersionsWithContext is an alternate form of the GetQueueManagerAvailableUpgradeVersions method which supports a Context parameter
+func (instance *ServiceInstance) GetQueueManagerAvailableUpgradeVersionsWithContext(ctx context.Context, getQueueManagerAvailableUpgradeVersionsOptions *GetQueueManagerAvailableUpgradeVersionsOptions) (result *QueueManagerVersionUpgrades, response *core.DetailedResponse, err error) {
+	bound := &GetQueueManagerAvailableUpgradeVersionsOptions{}
+	if getQueueManagerAvailableUpgradeVersionsOptions != nil {
+		optionsCopy := *getQueueManagerAvailableUpgradeVersionsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetQueueManagerAvailableUpgradeVersionsWithContext(ctx, bound)
+}
+
+// GetQueueManagerConnectionInfo : Get connection information for a queue manager
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetQueueManagerConnectionInfo(getQueueManagerConnectionInfoOptions *GetQueueManagerConnectionInfoOptions) (result *ConnectionInfo, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetQueueManagerConnectionInfoWithContext(context.Background(), getQueueManagerConnectionInfoOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetQueueManagerConnectionInfoWithContext is an alternate form of the GetQueueManagerConnectionInfo method which supports a Context parameter
+func (instance *ServiceInstance) GetQueueManagerConnectionInfoWithContext(ctx context.Context, getQueueManagerConnectionInfoOptions *GetQueueManagerConnectionInfoOptions) (result *ConnectionInfo, response *core.DetailedResponse, err error) {
+	bound := &GetQueueManagerConnectionInfoOptions{}
+	if getQueueManagerConnectionInfoOptions != nil {
+		optionsCopy := *getQueueManagerConnectionInfoOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetQueueManagerConnectionInfoWithContext(ctx, bound)
+}
+
+// GetQueueManagerStatus : Get the status of the queue manager
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetQueueManagerStatus(getQueueManagerStatusOptions *GetQueueManagerStatusOptions) (result *QueueManagerStatus, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetQueueManagerStatusWithContext(context.Background(), getQueueManagerStatusOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetQueueManagerStatusWithContext is an alternate form of the GetQueueManagerStatus method which supports a Context parameter
+func (instance *ServiceInstance) GetQueueManagerStatusWithContext(ctx context.Context, getQueueManagerStatusOptions *GetQueueManagerStatusOptions) (result *QueueManagerStatus, response *core.DetailedResponse, err error) {
+	bound := &GetQueueManagerStatusOptions{}
+	if getQueueManagerStatusOptions != nil {
+		optionsCopy := *getQueueManagerStatusOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetQueueManagerStatusWithContext(ctx, bound)
+}
+
+// ListUsers : Get a list of users for an instance
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) ListUsers(listUsersOptions *ListUsersOptions) (result *UserDetailsCollection, response *core.DetailedResponse, err error) {
+	result, response, err = instance.ListUsersWithContext(context.Background(), listUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListUsersWithContext is an alternate form of the ListUsers method which supports a Context parameter
+func (instance *ServiceInstance) ListUsersWithContext(ctx context.Context, listUsersOptions *ListUsersOptions) (result *UserDetailsCollection, response *core.DetailedResponse, err error) {
+	bound := &ListUsersOptions{}
+	if listUsersOptions != nil {
+		optionsCopy := *listUsersOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.ListUsersWithContext(ctx, bound)
+}
+
+// CreateUser : Add a user to an instance
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) CreateUser(createUserOptions *CreateUserOptions) (result *UserDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.CreateUserWithContext(context.Background(), createUserOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateUserWithContext is an alternate form of the CreateUser method which supports a Context parameter
+func (instance *ServiceInstance) CreateUserWithContext(ctx context.Context, createUserOptions *CreateUserOptions) (result *UserDetails, response *core.DetailedResponse, err error) {
+	bound := &CreateUserOptions{}
+	if createUserOptions != nil {
+		optionsCopy := *createUserOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.CreateUserWithContext(ctx, bound)
+}
+
+// GetUser : Get a user for an instance
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetUser(getUserOptions *GetUserOptions) (result *UserDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetUserWithContext(context.Background(), getUserOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetUserWithContext is an alternate form of the GetUser method which supports a Context parameter
+func (instance *ServiceInstance) GetUserWithContext(ctx context.Context, getUserOptions *GetUserOptions) (result *UserDetails, response *core.DetailedResponse, err error) {
+	bound := &GetUserOptions{}
+	if getUserOptions != nil {
+		optionsCopy := *getUserOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetUserWithContext(ctx, bound)
+}
+
+// DeleteUser : Delete a user for an instance
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) DeleteUser(deleteUserOptions *DeleteUserOptions) (response *core.DetailedResponse, err error) {
+	response, err = instance.DeleteUserWithContext(context.Background(), deleteUserOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteUserWithContext is an alternate form of the DeleteUser method which supports a Context parameter
+func (instance *ServiceInstance) DeleteUserWithContext(ctx context.Context, deleteUserOptions *DeleteUserOptions) (response *core.DetailedResponse, err error) {
+	bound := &DeleteUserOptions{}
+	if deleteUserOptions != nil {
+		optionsCopy := *deleteUserOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.DeleteUserWithContext(ctx, bound)
+}
+
+// ListApplications : Get a list of applications for an instance
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) ListApplications(listApplicationsOptions *ListApplicationsOptions) (result *ApplicationDetailsCollection, response *core.DetailedResponse, err error) {
+	result, response, err = instance.ListApplicationsWithContext(context.Background(), listApplicationsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListApplicationsWithContext is an alternate form of the ListApplications method which supports a Context parameter
+func (instance *ServiceInstance) ListApplicationsWithContext(ctx context.Context, listApplicationsOptions *ListApplicationsOptions) (result *ApplicationDetailsCollection, response *core.DetailedResponse, err error) {
+	bound := &ListApplicationsOptions{}
+	if listApplicationsOptions != nil {
+		optionsCopy := *listApplicationsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.ListApplicationsWithContext(ctx, bound)
+}
+
+// CreateApplication : Add an application to an instance
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) CreateApplication(createApplicationOptions *CreateApplicationOptions) (result *ApplicationCreated, response *core.DetailedResponse, err error) {
+	result, response, err = instance.CreateApplicationWithContext(context.Background(), createApplicationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateApplicationWithContext is an alternate form of the CreateApplication method which supports a Context parameter
+func (instance *ServiceInstance) CreateApplicationWithContext(ctx context.Context, createApplicationOptions *CreateApplicationOptions) (result *ApplicationCreated, response *core.DetailedResponse, err error) {
+	bound := &CreateApplicationOptions{}
+	if createApplicationOptions != nil {
+		optionsCopy := *createApplicationOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.CreateApplicationWithContext(ctx, bound)
+}
+
+// GetApplication : Get an application for an instance
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetApplication(getApplicationOptions *GetApplicationOptions) (result *ApplicationDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetApplicationWithContext(context.Background(), getApplicationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetApplicationWithContext is an alternate form of the GetApplication method which supports a Context parameter
+func (instance *ServiceInstance) GetApplicationWithContext(ctx context.Context, getApplicationOptions *GetApplicationOptions) (result *ApplicationDetails, response *core.DetailedResponse, err error) {
+	bound := &GetApplicationOptions{}
+	if getApplicationOptions != nil {
+		optionsCopy := *getApplicationOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetApplicationWithContext(ctx, bound)
+}
+
+// DeleteApplication : Delete an application from an instance
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) DeleteApplication(deleteApplicationOptions *DeleteApplicationOptions) (response *core.DetailedResponse, err error) {
+	response, err = instance.DeleteApplicationWithContext(context.Background(), deleteApplicationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteApplicationWithContext is an alternate form of the DeleteApplication method which supports a Context parameter
+func (instance *ServiceInstance) DeleteApplicationWithContext(ctx context.Context, deleteApplicationOptions *DeleteApplicationOptions) (response *core.DetailedResponse, err error) {
+	bound := &DeleteApplicationOptions{}
+	if deleteApplicationOptions != nil {
+		optionsCopy := *deleteApplicationOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.DeleteApplicationWithContext(ctx, bound)
+}
+
+// CreateApplicationApikey : Create a new apikey for an application
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) CreateApplicationApikey(createApplicationApikeyOptions *CreateApplicationApikeyOptions) (result *ApplicationAPIKeyCreated, response *core.DetailedResponse, err error) {
+	result, response, err = instance.CreateApplicationApikeyWithContext(context.Background(), createApplicationApikeyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateApplicationApikeyWithContext is an alternate form of the CreateApplicationApikey method which supports a Context parameter
+func (instance *ServiceInstance) CreateApplicationApikeyWithContext(ctx context.Context, createApplicationApikeyOptions *CreateApplicationApikeyOptions) (result *ApplicationAPIKeyCreated, response *core.DetailedResponse, err error) {
+	bound := &CreateApplicationApikeyOptions{}
+	if createApplicationApikeyOptions != nil {
+		optionsCopy := *createApplicationApikeyOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.CreateApplicationApikeyWithContext(ctx, bound)
+}
+
+// CreateTrustStorePemCertificate : Upload a trust store certificate
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) CreateTrustStorePemCertificate(createTrustStorePemCertificateOptions *CreateTrustStorePemCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.CreateTrustStorePemCertificateWithContext(context.Background(), createTrustStorePemCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateTrustStorePemCertificateWithContext is an alternate form of the CreateTrustStorePemCertificate method which supports a Context parameter
+func (instance *ServiceInstance) CreateTrustStorePemCertificateWithContext(ctx context.Context, createTrustStorePemCertificateOptions *CreateTrustStorePemCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	bound := &CreateTrustStorePemCertificateOptions{}
+	if createTrustStorePemCertificateOptions != nil {
+		optionsCopy := *createTrustStorePemCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.CreateTrustStorePemCertificateWithContext(ctx, bound)
+}
+
+// ListTrustStoreCertificates : List trust store certificates
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) ListTrustStoreCertificates(listTrustStoreCertificatesOptions *ListTrustStoreCertificatesOptions) (result *TrustStoreCertificateDetailsCollection, response *core.DetailedResponse, err error) {
+	result, response, err = instance.ListTrustStoreCertificatesWithContext(context.Background(), listTrustStoreCertificatesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListTrustStoreCertificatesWithContext is an alternate form of the ListTrustStoreCertificates method which supports a Context parameter
+func (instance *ServiceInstance) ListTrustStoreCertificatesWithContext(ctx context.Context, listTrustStoreCertificatesOptions *ListTrustStoreCertificatesOptions) (result *TrustStoreCertificateDetailsCollection, response *core.DetailedResponse, err error) {
+	bound := &ListTrustStoreCertificatesOptions{}
+	if listTrustStoreCertificatesOptions != nil {
+		optionsCopy := *listTrustStoreCertificatesOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.ListTrustStoreCertificatesWithContext(ctx, bound)
+}
+
+// GetTrustStoreCertificate : Get a trust store certificate
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetTrustStoreCertificate(getTrustStoreCertificateOptions *GetTrustStoreCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetTrustStoreCertificateWithContext(context.Background(), getTrustStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetTrustStoreCertificateWithContext is an alternate form of the GetTrustStoreCertificate method which supports a Context parameter
+func (instance *ServiceInstance) GetTrustStoreCertificateWithContext(ctx context.Context, getTrustStoreCertificateOptions *GetTrustStoreCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	bound := &GetTrustStoreCertificateOptions{}
+	if getTrustStoreCertificateOptions != nil {
+		optionsCopy := *getTrustStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetTrustStoreCertificateWithContext(ctx, bound)
+}
+
+// DeleteTrustStoreCertificate : Delete a trust store certificate
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) DeleteTrustStoreCertificate(deleteTrustStoreCertificateOptions *DeleteTrustStoreCertificateOptions) (response *core.DetailedResponse, err error) {
+	response, err = instance.DeleteTrustStoreCertificateWithContext(context.Background(), deleteTrustStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteTrustStoreCertificateWithContext is an alternate form of the DeleteTrustStoreCertificate method which supports a Context parameter
+func (instance *ServiceInstance) DeleteTrustStoreCertificateWithContext(ctx context.Context, deleteTrustStoreCertificateOptions *DeleteTrustStoreCertificateOptions) (response *core.DetailedResponse, err error) {
+	bound := &DeleteTrustStoreCertificateOptions{}
+	if deleteTrustStoreCertificateOptions != nil {
+		optionsCopy := *deleteTrustStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.DeleteTrustStoreCertificateWithContext(ctx, bound)
+}
+
+// DownloadTrustStoreCertificate : Download a queue manager's certificate from its trust store
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) DownloadTrustStoreCertificate(downloadTrustStoreCertificateOptions *DownloadTrustStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	result, response, err = instance.DownloadTrustStoreCertificateWithContext(context.Background(), downloadTrustStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DownloadTrustStoreCertificateWithContext is an alternate form of the DownloadTrustStoreCertificate method which supports a Context parameter
+func (instance *ServiceInstance) DownloadTrustStoreCertificateWithContext(ctx context.Context, downloadTrustStoreCertificateOptions *DownloadTrustStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	bound := &DownloadTrustStoreCertificateOptions{}
+	if downloadTrustStoreCertificateOptions != nil {
+		optionsCopy := *downloadTrustStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.DownloadTrustStoreCertificateWithContext(ctx, bound)
+}
+
+// CreateKeyStorePemCertificate : Upload a key store certificate
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) CreateKeyStorePemCertificate(createKeyStorePemCertificateOptions *CreateKeyStorePemCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.CreateKeyStorePemCertificateWithContext(context.Background(), createKeyStorePemCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateKeyStorePemCertificateWithContext is an alternate form of the CreateKeyStorePemCertificate method which supports a Context parameter
+func (instance *ServiceInstance) CreateKeyStorePemCertificateWithContext(ctx context.Context, createKeyStorePemCertificateOptions *CreateKeyStorePemCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	bound := &CreateKeyStorePemCertificateOptions{}
+	if createKeyStorePemCertificateOptions != nil {
+		optionsCopy := *createKeyStorePemCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.CreateKeyStorePemCertificateWithContext(ctx, bound)
+}
+
+// ListKeyStoreCertificates : List key store certificates
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) ListKeyStoreCertificates(listKeyStoreCertificatesOptions *ListKeyStoreCertificatesOptions) (result *KeyStoreCertificateDetailsCollection, response *core.DetailedResponse, err error) {
+	result, response, err = instance.ListKeyStoreCertificatesWithContext(context.Background(), listKeyStoreCertificatesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListKeyStoreCertificatesWithContext is an alternate form of the ListKeyStoreCertificates method which supports a Context parameter
+func (instance *ServiceInstance) ListKeyStoreCertificatesWithContext(ctx context.Context, listKeyStoreCertificatesOptions *ListKeyStoreCertificatesOptions) (result *KeyStoreCertificateDetailsCollection, response *core.DetailedResponse, err error) {
+	bound := &ListKeyStoreCertificatesOptions{}
+	if listKeyStoreCertificatesOptions != nil {
+		optionsCopy := *listKeyStoreCertificatesOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.ListKeyStoreCertificatesWithContext(ctx, bound)
+}
+
+// GetKeyStoreCertificate : Get a key store certificate for queue manager
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetKeyStoreCertificate(getKeyStoreCertificateOptions *GetKeyStoreCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetKeyStoreCertificateWithContext(context.Background(), getKeyStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetKeyStoreCertificateWithContext is an alternate form of the GetKeyStoreCertificate method which supports a Context parameter
+func (instance *ServiceInstance) GetKeyStoreCertificateWithContext(ctx context.Context, getKeyStoreCertificateOptions *GetKeyStoreCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	bound := &GetKeyStoreCertificateOptions{}
+	if getKeyStoreCertificateOptions != nil {
+		optionsCopy := *getKeyStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetKeyStoreCertificateWithContext(ctx, bound)
+}
+
+// DeleteKeyStoreCertificate : Delete a queue manager's key store certificate
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) DeleteKeyStoreCertificate(deleteKeyStoreCertificateOptions *DeleteKeyStoreCertificateOptions) (response *core.DetailedResponse, err error) {
+	response, err = instance.DeleteKeyStoreCertificateWithContext(context.Background(), deleteKeyStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteKeyStoreCertificateWithContext is an alternate form of the DeleteKeyStoreCertificate method which supports a Context parameter
+func (instance *ServiceInstance) DeleteKeyStoreCertificateWithContext(ctx context.Context, deleteKeyStoreCertificateOptions *DeleteKeyStoreCertificateOptions) (response *core.DetailedResponse, err error) {
+	bound := &DeleteKeyStoreCertificateOptions{}
+	if deleteKeyStoreCertificateOptions != nil {
+		optionsCopy := *deleteKeyStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.DeleteKeyStoreCertificateWithContext(ctx, bound)
+}
+
+// DownloadKeyStoreCertificate : Download a queue manager's certificate from its key store
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) DownloadKeyStoreCertificate(downloadKeyStoreCertificateOptions *DownloadKeyStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	result, response, err = instance.DownloadKeyStoreCertificateWithContext(context.Background(), downloadKeyStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DownloadKeyStoreCertificateWithContext is an alternate form of the DownloadKeyStoreCertificate method which supports a Context parameter
+func (instance *ServiceInstance) DownloadKeyStoreCertificateWithContext(ctx context.Context, downloadKeyStoreCertificateOptions *DownloadKeyStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	bound := &DownloadKeyStoreCertificateOptions{}
+	if downloadKeyStoreCertificateOptions != nil {
+		optionsCopy := *downloadKeyStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.DownloadKeyStoreCertificateWithContext(ctx, bound)
+}
+
+// GetCertificateAmsChannels : Get the AMS channels that are configured with this key store certificate
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GetCertificateAmsChannels(getCertificateAmsChannelsOptions *GetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.GetCertificateAmsChannelsWithContext(context.Background(), getCertificateAmsChannelsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCertificateAmsChannelsWithContext is an alternate form of the GetCertificateAmsChannels method which supports a Context parameter
+func (instance *ServiceInstance) GetCertificateAmsChannelsWithContext(ctx context.Context, getCertificateAmsChannelsOptions *GetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error) {
+	bound := &GetCertificateAmsChannelsOptions{}
+	if getCertificateAmsChannelsOptions != nil {
+		optionsCopy := *getCertificateAmsChannelsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.GetCertificateAmsChannelsWithContext(ctx, bound)
+}
+
+// SetCertificateAmsChannels : Update the AMS channels that are configured with this key store certificate
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) SetCertificateAmsChannels(setCertificateAmsChannelsOptions *SetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error) {
+	result, response, err = instance.SetCertificateAmsChannelsWithContext(context.Background(), setCertificateAmsChannelsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetCertificateAmsChannelsWithContext is an alternate form of the SetCertificateAmsChannels method which supports a Context parameter
+func (instance *ServiceInstance) SetCertificateAmsChannelsWithContext(ctx context.Context, setCertificateAmsChannelsOptions *SetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error) {
+	bound := &SetCertificateAmsChannelsOptions{}
+	if setCertificateAmsChannelsOptions != nil {
+		optionsCopy := *setCertificateAmsChannelsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.SetCertificateAmsChannelsWithContext(ctx, bound)
+}
+
+// GenerateAmsReport : Report on AMS channel protection across queue managers
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) GenerateAmsReport(options *AmsReportOptions) (report *AmsReport, err error) {
+	report, err = instance.GenerateAmsReportWithContext(context.Background(), options)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GenerateAmsReportWithContext is an alternate form of the GenerateAmsReport method which supports a Context parameter
+func (instance *ServiceInstance) GenerateAmsReportWithContext(ctx context.Context, options *AmsReportOptions) (report *AmsReport, err error) {
+	bound := &AmsReportOptions{}
+	if options != nil {
+		optionsCopy := *options
+		bound = &optionsCopy
+	}
+	guid, err := instance.bind(core.StringPtr(bound.ServiceInstanceGuid))
+	if err != nil {
+		return
+	}
+	bound.ServiceInstanceGuid = *guid
+	return instance.client.GenerateAmsReportWithContext(ctx, bound)
+}
+
+// RotateApplicationApikey : Create a new api key for an application and store it
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) RotateApplicationApikey(rotateApplicationApikeyOptions *RotateApplicationApikeyOptions) (result *ApplicationAPIKeyCreated, metadata *APIKeyMetadata, err error) {
+	result, metadata, err = instance.RotateApplicationApikeyWithContext(context.Background(), rotateApplicationApikeyOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RotateApplicationApikeyWithContext is an alternate form of the RotateApplicationApikey method which supports a Context parameter
+func (instance *ServiceInstance) RotateApplicationApikeyWithContext(ctx context.Context, rotateApplicationApikeyOptions *RotateApplicationApikeyOptions) (result *ApplicationAPIKeyCreated, metadata *APIKeyMetadata, err error) {
+	bound := &RotateApplicationApikeyOptions{}
+	if rotateApplicationApikeyOptions != nil {
+		optionsCopy := *rotateApplicationApikeyOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.RotateApplicationApikeyWithContext(ctx, bound)
+}
+
+// EnsureApplication : Get or create an application, storing any new api key in a sink
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) EnsureApplication(ensureApplicationOptions *EnsureApplicationOptions) (result *EnsureApplicationResult, err error) {
+	result, err = instance.EnsureApplicationWithContext(context.Background(), ensureApplicationOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// EnsureApplicationWithContext is an alternate form of the EnsureApplication method which supports a Context parameter
+func (instance *ServiceInstance) EnsureApplicationWithContext(ctx context.Context, ensureApplicationOptions *EnsureApplicationOptions) (result *EnsureApplicationResult, err error) {
+	bound := &EnsureApplicationOptions{}
+	if ensureApplicationOptions != nil {
+		optionsCopy := *ensureApplicationOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.EnsureApplicationWithContext(ctx, bound)
+}
+
+// FindUserByEmail : Find a user by email
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) FindUserByEmail(findUserByEmailOptions *FindUserByEmailOptions) (result *UserDetails, err error) {
+	result, err = instance.FindUserByEmailWithContext(context.Background(), findUserByEmailOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// FindUserByEmailWithContext is an alternate form of the FindUserByEmail method which supports a Context parameter
+func (instance *ServiceInstance) FindUserByEmailWithContext(ctx context.Context, findUserByEmailOptions *FindUserByEmailOptions) (result *UserDetails, err error) {
+	bound := &FindUserByEmailOptions{}
+	if findUserByEmailOptions != nil {
+		optionsCopy := *findUserByEmailOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.FindUserByEmailWithContext(ctx, bound)
+}
+
+// FindUserByName : Find a user by shortname
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) FindUserByName(findUserByNameOptions *FindUserByNameOptions) (result *UserDetails, err error) {
+	result, err = instance.FindUserByNameWithContext(context.Background(), findUserByNameOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// FindUserByNameWithContext is an alternate form of the FindUserByName method which supports a Context parameter
+func (instance *ServiceInstance) FindUserByNameWithContext(ctx context.Context, findUserByNameOptions *FindUserByNameOptions) (result *UserDetails, err error) {
+	bound := &FindUserByNameOptions{}
+	if findUserByNameOptions != nil {
+		optionsCopy := *findUserByNameOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.FindUserByNameWithContext(ctx, bound)
+}
+
+// FindApplicationByName : Find an application by name
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) FindApplicationByName(findApplicationByNameOptions *FindApplicationByNameOptions) (result *ApplicationDetails, err error) {
+	result, err = instance.FindApplicationByNameWithContext(context.Background(), findApplicationByNameOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// FindApplicationByNameWithContext is an alternate form of the FindApplicationByName method which supports a Context parameter
+func (instance *ServiceInstance) FindApplicationByNameWithContext(ctx context.Context, findApplicationByNameOptions *FindApplicationByNameOptions) (result *ApplicationDetails, err error) {
+	bound := &FindApplicationByNameOptions{}
+	if findApplicationByNameOptions != nil {
+		optionsCopy := *findApplicationByNameOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.FindApplicationByNameWithContext(ctx, bound)
+}
+
+// ImportUsers : Provision users in bulk
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) ImportUsers(importUsersOptions *ImportUsersOptions) (report *UserImportReport, err error) {
+	report, err = instance.ImportUsersWithContext(context.Background(), importUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ImportUsersWithContext is an alternate form of the ImportUsers method which supports a Context parameter
+func (instance *ServiceInstance) ImportUsersWithContext(ctx context.Context, importUsersOptions *ImportUsersOptions) (report *UserImportReport, err error) {
+	bound := &ImportUsersOptions{}
+	if importUsersOptions != nil {
+		optionsCopy := *importUsersOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.ImportUsersWithContext(ctx, bound)
+}
+
+// PlanUserReconciliation : Plan the changes that make the instance users match a desired list
+// The options are copied and their ServiceInstanceGuid set to the instance GUID.
+func (instance *ServiceInstance) PlanUserReconciliation(reconcileUsersOptions *ReconcileUsersOptions) (plan *UserReconciliationPlan, err error) {
+	plan, err = instance.PlanUserReconciliationWithContext(context.Background(), reconcileUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanUserReconciliationWithContext is an alternate form of the PlanUserReconciliation method which supports a Context parameter
+func (instance *ServiceInstance) PlanUserReconciliationWithContext(ctx context.Context, reconcileUsersOptions *ReconcileUsersOptions) (plan *UserReconciliationPlan, err error) {
+	bound := &ReconcileUsersOptions{}
+	if reconcileUsersOptions != nil {
+		optionsCopy := *reconcileUsersOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	return instance.client.PlanUserReconciliationWithContext(ctx, bound)
+}
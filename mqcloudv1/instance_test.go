@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ServiceInstance`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		server         *userTestServer
+		mqcloudService *mqcloudv1.MqcloudV1
+		instance       *mqcloudv1.ServiceInstance
+	)
+
+	BeforeEach(func() {
+		server = newUserTestServer(serviceInstanceGuid,
+			[2]string{"alice@example.com", "alice"},
+			[2]string{"bob@example.com", "bob"},
+			[2]string{"carol@example.com", "carol"})
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		instance = mqcloudService.Instance(serviceInstanceGuid)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Fills in the service instance GUID`, func() {
+		Expect(instance.GUID()).To(Equal(serviceInstanceGuid))
+		Expect(instance.Client()).To(BeIdenticalTo(mqcloudService))
+
+		users, response, err := instance.ListUsers(nil)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(users.Users).To(HaveLen(2))
+
+		user, err := instance.FindUserByName(&mqcloudv1.FindUserByNameOptions{Name: core.StringPtr("carol")})
+		Expect(err).To(BeNil())
+		Expect(*user.ID).To(Equal("user03"))
+	})
+	It(`Does not modify the caller's options`, func() {
+		options := &mqcloudv1.DeleteUserOptions{UserID: core.StringPtr("user02")}
+		response, err := instance.DeleteUser(options)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+		Expect(options.ServiceInstanceGuid).To(BeNil())
+		Expect(server.deletes).To(Equal([]string{"user02"}))
+	})
+	It(`Rejects options for another service instance`, func() {
+		options := mqcloudService.NewListUsersOptions("another-instance")
+		_, _, err := instance.ListUsers(options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("another-instance"))
+		Expect(server.listCalls).To(Equal(0))
+
+		_, err = mqcloudService.Instance("").NewUsersPager(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Creates pagers bound to the instance`, func() {
+		pager, err := instance.NewUsersPager(nil)
+		Expect(err).To(BeNil())
+		users, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(users).To(HaveLen(3))
+	})
+	It(`Can be used from several goroutines`, func() {
+		names := []string{"alice", "bob", "carol"}
+		ids := make([]string, len(names))
+		var wg sync.WaitGroup
+		for i := range names {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				user, err := instance.FindUserByName(&mqcloudv1.FindUserByNameOptions{Name: core.StringPtr(names[i])})
+				Expect(err).To(BeNil())
+				ids[i] = *user.ID
+			}(i)
+		}
+		wg.Wait()
+		Expect(ids).To(Equal([]string{"user01", "user02", "user03"}))
+	})
+})
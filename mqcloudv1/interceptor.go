@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// Invocation : One call of an operation, as seen by interceptors.
+type Invocation struct {
+	// The operation ID passed to common.GetSdkHeaders, such as "CreateQueueManager".
+	OperationID string
+
+	// The options the operation was called with, such as *CreateQueueManagerOptions.
+	Options interface{}
+
+	// The request built for the operation. Interceptors may change it, or replace it with a new
+	// request, before calling the next invoker.
+	Request *http.Request
+}
+
+// Invoker : Carries out an invocation and returns the response.
+type Invoker func(invocation *Invocation) (*core.DetailedResponse, error)
+
+// Interceptor : Wraps the calls of every operation.
+// An interceptor can act before the call, by changing the invocation, and after it, by inspecting or
+// replacing the response and error returned by next. It can also return without calling next, to
+// answer the call itself; the RawResult of its response, or its Result, is then decoded as if it had
+// come from the service.
+type Interceptor func(invocation *Invocation, next Invoker) (*core.DetailedResponse, error)
+
+// ChainInterceptors returns an interceptor that runs the given interceptors in order, the first
+// outermost.
+func ChainInterceptors(interceptors ...Interceptor) Interceptor {
+	interceptors = append([]Interceptor(nil), interceptors...)
+	return func(invocation *Invocation, next Invoker) (*core.DetailedResponse, error) {
+		return chainInvoker(interceptors, next)(invocation)
+	}
+}
+
+// chainInvoker returns an invoker that runs the interceptors around next.
+func chainInvoker(interceptors []Interceptor, next Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := interceptors[i], next
+		next = func(invocation *Invocation) (*core.DetailedResponse, error) {
+			return interceptor(invocation, inner)
+		}
+	}
+	return next
+}
+
+// AddInterceptors adds interceptors to the client, inside any it already has. Clones made afterwards
+// inherit them; interceptors added to a clone do not affect the original.
+func (mqcloud *MqcloudV1) AddInterceptors(interceptors ...Interceptor) {
+	combined := make([]Interceptor, 0, len(mqcloud.interceptors)+len(interceptors))
+	combined = append(combined, mqcloud.interceptors...)
+	mqcloud.interceptors = append(combined, interceptors...)
+}
+
+// SetInterceptors replaces the client's interceptors. Calling it with none removes them all.
+func (mqcloud *MqcloudV1) SetInterceptors(interceptors ...Interceptor) {
+	mqcloud.interceptors = append([]Interceptor(nil), interceptors...)
+}
+
+// GetInterceptors returns the client's interceptors, outermost first.
+func (mqcloud *MqcloudV1) GetInterceptors() []Interceptor {
+	return append([]Interceptor(nil), mqcloud.interceptors...)
+}
+
+// intercept runs an operation's request through the client's interceptors.
+func (mqcloud *MqcloudV1) intercept(state *operationState, options interface{}, req *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	invoked := false
+	invoke := chainInvoker(mqcloud.interceptors, func(invocation *Invocation) (*core.DetailedResponse, error) {
+		invoked = true
+		return mqcloud.invoke(state, invocation.Options, invocation.Request, result)
+	})
+	response, err := invoke(&Invocation{OperationID: state.id, Options: options, Request: req})
+	if !invoked && err == nil && response != nil && result != nil {
+		if err = decodeInterceptedResult(response, result); err != nil {
+			err = core.SDKErrorf(err, "", "intercepted-result-error", common.GetComponentInfo())
+		}
+	}
+	return response, err
+}
+
+// decodeInterceptedResult decodes the response of an interceptor that answered a call itself into
+// the operation's result.
+func decodeInterceptedResult(response *core.DetailedResponse, result interface{}) error {
+	if stream, ok := result.(*io.ReadCloser); ok {
+		if body, ok := response.Result.(io.ReadCloser); ok {
+			*stream = body
+		} else if response.RawResult != nil {
+			*stream = io.NopCloser(bytes.NewReader(response.RawResult))
+		}
+		return nil
+	}
+
+	raw := response.RawResult
+	if raw == nil && response.Result != nil {
+		var err error
+		if raw, err = json.Marshal(response.Result); err != nil {
+			return err
+		}
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, result)
+}
@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Interceptors`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		requests       []*http.Request
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req)
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Path == "/v1/"+serviceInstanceGuid+"/queue_managers/missing" {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors":[{"code":"not_found","message":"no such queue manager"}]}`)
+				return
+			}
+			fmt.Fprint(res, `{"id":"qm1","name":"from_server"}`)
+		}))
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	getQueueManager := func(service *mqcloudv1.MqcloudV1, id string) (*mqcloudv1.QueueManagerDetails, *core.DetailedResponse, error) {
+		return service.GetQueueManager(&mqcloudv1.GetQueueManagerOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			QueueManagerID:      core.StringPtr(id),
+		})
+	}
+
+	It(`Sees the operation before and after the call`, func() {
+		var seen []string
+		mqcloudService.AddInterceptors(func(invocation *mqcloudv1.Invocation, next mqcloudv1.Invoker) (*core.DetailedResponse, error) {
+			options := invocation.Options.(*mqcloudv1.GetQueueManagerOptions)
+			seen = append(seen, invocation.OperationID+" "+*options.QueueManagerID)
+			invocation.Request.Header.Set("X-Test", "intercepted")
+			response, err := next(invocation)
+			seen = append(seen, fmt.Sprintf("%d %t", response.StatusCode, err != nil))
+			return response, err
+		})
+
+		details, _, err := getQueueManager(mqcloudService, "qm1")
+		Expect(err).To(BeNil())
+		Expect(*details.Name).To(Equal("from_server"))
+		_, _, err = getQueueManager(mqcloudService, "missing")
+		Expect(mqcloudv1.IsNotFound(err)).To(BeTrue())
+
+		Expect(seen).To(Equal([]string{"GetQueueManager qm1", "200 false", "GetQueueManager missing", "404 true"}))
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0].Header.Get("X-Test")).To(Equal("intercepted"))
+	})
+	It(`Runs chained interceptors in order, the first outermost`, func() {
+		var order []string
+		tracer := func(name string) mqcloudv1.Interceptor {
+			return func(invocation *mqcloudv1.Invocation, next mqcloudv1.Invoker) (*core.DetailedResponse, error) {
+				order = append(order, "before "+name)
+				defer func() { order = append(order, "after "+name) }()
+				return next(invocation)
+			}
+		}
+		mqcloudService.AddInterceptors(mqcloudv1.ChainInterceptors(tracer("a"), tracer("b")))
+		mqcloudService.AddInterceptors(tracer("c"))
+		Expect(mqcloudService.GetInterceptors()).To(HaveLen(2))
+
+		_, _, err := getQueueManager(mqcloudService, "qm1")
+		Expect(err).To(BeNil())
+		Expect(order).To(Equal([]string{"before a", "before b", "before c", "after c", "after b", "after a"}))
+
+		mqcloudService.SetInterceptors()
+		order = nil
+		_, _, err = getQueueManager(mqcloudService, "qm1")
+		Expect(err).To(BeNil())
+		Expect(order).To(BeEmpty())
+	})
+	It(`Decodes the response of an interceptor that answers the call itself`, func() {
+		mqcloudService.AddInterceptors(func(invocation *mqcloudv1.Invocation, next mqcloudv1.Invoker) (*core.DetailedResponse, error) {
+			switch invocation.OperationID {
+			case "GetQueueManager":
+				return &core.DetailedResponse{StatusCode: 200, RawResult: []byte(`{"id":"qm1","name":"cached"}`)}, nil
+			case "ListQueueManagers":
+				return &core.DetailedResponse{StatusCode: 200, Result: map[string]interface{}{"queue_managers": []interface{}{map[string]interface{}{"name": "cached"}}}}, nil
+			case "DownloadKeyStoreCertificate":
+				return &core.DetailedResponse{StatusCode: 200, RawResult: []byte("PEM")}, nil
+			}
+			return next(invocation)
+		})
+
+		details, response, err := getQueueManager(mqcloudService, "qm1")
+		Expect(err).To(BeNil())
+		Expect(*details.Name).To(Equal("cached"))
+		Expect(response.Result).To(Equal(details))
+
+		list, _, err := mqcloudService.ListQueueManagers(&mqcloudv1.ListQueueManagersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		})
+		Expect(err).To(BeNil())
+		Expect(*list.QueueManagers[0].Name).To(Equal("cached"))
+
+		download, _, err := mqcloudService.DownloadKeyStoreCertificate(&mqcloudv1.DownloadKeyStoreCertificateOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			QueueManagerID:      core.StringPtr("qm1"),
+			CertificateID:       core.StringPtr("cert1"),
+		})
+		Expect(err).To(BeNil())
+		data, _ := io.ReadAll(download)
+		Expect(string(data)).To(Equal("PEM"))
+		Expect(requests).To(BeEmpty())
+	})
+	It(`Is inherited by clones without sharing later additions`, func() {
+		calls := 0
+		mqcloudService.AddInterceptors(func(invocation *mqcloudv1.Invocation, next mqcloudv1.Invoker) (*core.DetailedResponse, error) {
+			calls++
+			return next(invocation)
+		})
+		clone := mqcloudService.Clone()
+		clone.AddInterceptors(func(invocation *mqcloudv1.Invocation, next mqcloudv1.Invoker) (*core.DetailedResponse, error) {
+			body, _ := json.Marshal(map[string]string{"name": "clone"})
+			return &core.DetailedResponse{StatusCode: 200, RawResult: body}, nil
+		})
+
+		details, _, err := getQueueManager(clone, "qm1")
+		Expect(err).To(BeNil())
+		Expect(*details.Name).To(Equal("clone"))
+		details, _, err = getQueueManager(mqcloudService, "qm1")
+		Expect(err).To(BeNil())
+		Expect(*details.Name).To(Equal("from_server"))
+		Expect(calls).To(Equal(2))
+		Expect(mqcloudService.GetInterceptors()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,442 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// NotFoundError : No resource matched a lookup.
+// Use errors.As to test for it; it is wrapped by the SDK problem the lookup returns.
+type NotFoundError struct {
+	// The kind of resource looked up, "user" or "application".
+	Resource string
+
+	// The field matched on, "email" or "name".
+	Field string
+
+	// The value looked up.
+	Value string
+
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid string
+}
+
+// Error implements the error interface.
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("no %s with %s '%s' in service instance %s", e.Resource, e.Field, e.Value, e.ServiceInstanceGuid)
+}
+
+// LookupCache : A short-lived index of users and applications shared by Find calls.
+// Each service instance's collection is paged through once and reused until it is older than the
+// TTL. A LookupCache is safe for concurrent use; concurrent lookups in the same collection share one
+// fetch, and lookups in other collections do not wait for it.
+type LookupCache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	mutex sync.Mutex
+
+	// The cached indexes, keyed by collection and service instance GUID.
+	entries map[string]*lookupEntry
+}
+
+// lookupEntry is a cached index, or the fetch of one that is still in progress.
+type lookupEntry struct {
+	// done is closed when the fetch ends; the other fields are only read after that.
+	done    chan struct{}
+	fetched time.Time
+	index   interface{}
+	err     error
+}
+
+type userIndex struct {
+	byEmail map[string]UserDetails
+	byName  map[string]UserDetails
+}
+
+type applicationIndex struct {
+	byName map[string]ApplicationDetails
+}
+
+// NewLookupCache returns a cache whose entries expire after ttl.
+func NewLookupCache(ttl time.Duration) *LookupCache {
+	return &LookupCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*lookupEntry),
+	}
+}
+
+// Invalidate discards every cached index, for example after creating or deleting users. Fetches in
+// progress still complete for the lookups waiting on them, but are not cached.
+func (cache *LookupCache) Invalidate() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.entries = make(map[string]*lookupEntry)
+}
+
+// load returns the index cached under key, calling fetch to build it if there is none or it has
+// expired. The mutex is only held to find or store the entry, never while fetch pages through the
+// collection. A lookup that finds a fetch in progress waits for it rather than starting another,
+// unless its own context ends first.
+func (cache *LookupCache) load(ctx context.Context, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	for {
+		cache.mutex.Lock()
+		entry := cache.entries[key]
+		if entry != nil && entry.finished() && (entry.err != nil || cache.expired(entry.fetched)) {
+			entry = nil
+		}
+		if entry == nil {
+			entry = &lookupEntry{done: make(chan struct{})}
+			cache.entries[key] = entry
+			cache.mutex.Unlock()
+
+			entry.index, entry.err = fetch()
+			entry.fetched = cache.now()
+			close(entry.done)
+			return entry.index, entry.err
+		}
+		cache.mutex.Unlock()
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, core.SDKErrorf(ctx.Err(), "", "context-done", common.GetComponentInfo())
+		}
+		// A fetch that failed only because the context of the lookup that started it ended is tried
+		// again with this lookup's context.
+		if entry.err != nil && (errors.Is(entry.err, context.Canceled) || errors.Is(entry.err, context.DeadlineExceeded)) && ctx.Err() == nil {
+			continue
+		}
+		return entry.index, entry.err
+	}
+}
+
+// finished returns true if the fetch of the entry has ended.
+func (entry *lookupEntry) finished() bool {
+	select {
+	case <-entry.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// FindUserByEmailOptions : The FindUserByEmail options.
+type FindUserByEmailOptions struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid *string `json:"service_instance_guid" validate:"required,ne="`
+
+	// The email of the user. Matched case insensitively.
+	Email *string `json:"email" validate:"required,ne="`
+
+	// An optional cache to look the user up in.
+	Cache *LookupCache `json:"-"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewFindUserByEmailOptions : Instantiate FindUserByEmailOptions
+func (*MqcloudV1) NewFindUserByEmailOptions(serviceInstanceGuid string, email string) *FindUserByEmailOptions {
+	return &FindUserByEmailOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		Email:               core.StringPtr(email),
+	}
+}
+
+// SetCache : Allow user to set Cache
+func (_options *FindUserByEmailOptions) SetCache(cache *LookupCache) *FindUserByEmailOptions {
+	_options.Cache = cache
+	return _options
+}
+
+// FindUserByNameOptions : The FindUserByName options.
+type FindUserByNameOptions struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid *string `json:"service_instance_guid" validate:"required,ne="`
+
+	// The shortname of the user.
+	Name *string `json:"name" validate:"required,ne="`
+
+	// An optional cache to look the user up in.
+	Cache *LookupCache `json:"-"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewFindUserByNameOptions : Instantiate FindUserByNameOptions
+func (*MqcloudV1) NewFindUserByNameOptions(serviceInstanceGuid string, name string) *FindUserByNameOptions {
+	return &FindUserByNameOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		Name:                core.StringPtr(name),
+	}
+}
+
+// SetCache : Allow user to set Cache
+func (_options *FindUserByNameOptions) SetCache(cache *LookupCache) *FindUserByNameOptions {
+	_options.Cache = cache
+	return _options
+}
+
+// FindApplicationByNameOptions : The FindApplicationByName options.
+type FindApplicationByNameOptions struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid *string `json:"service_instance_guid" validate:"required,ne="`
+
+	// The name of the application.
+	Name *string `json:"name" validate:"required,ne="`
+
+	// An optional cache to look the application up in.
+	Cache *LookupCache `json:"-"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// NewFindApplicationByNameOptions : Instantiate FindApplicationByNameOptions
+func (*MqcloudV1) NewFindApplicationByNameOptions(serviceInstanceGuid string, name string) *FindApplicationByNameOptions {
+	return &FindApplicationByNameOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		Name:                core.StringPtr(name),
+	}
+}
+
+// SetCache : Allow user to set Cache
+func (_options *FindApplicationByNameOptions) SetCache(cache *LookupCache) *FindApplicationByNameOptions {
+	_options.Cache = cache
+	return _options
+}
+
+// FindUserByEmail : Find a user by email
+// Pages through the users of the service instance until one with the email is found. Returns an
+// error wrapping a *NotFoundError if there is none.
+func (mqcloud *MqcloudV1) FindUserByEmail(findUserByEmailOptions *FindUserByEmailOptions) (result *UserDetails, err error) {
+	result, err = mqcloud.FindUserByEmailWithContext(context.Background(), findUserByEmailOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// FindUserByEmailWithContext is an alternate form of the FindUserByEmail method which supports a Context parameter
+func (mqcloud *MqcloudV1) FindUserByEmailWithContext(ctx context.Context, findUserByEmailOptions *FindUserByEmailOptions) (result *UserDetails, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "FindUserByEmail", QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuidOf(findUserByEmailOptions)})
+	defer func() {
+		end(err)
+	}()
+
+	err = validateFindOptions(findUserByEmailOptions, "findUserByEmailOptions")
+	if err != nil {
+		return
+	}
+	email := strings.ToLower(*findUserByEmailOptions.Email)
+	return mqcloud.findUser(ctx, findUserByEmailOptions.ServiceInstanceGuid, findUserByEmailOptions.Cache, findUserByEmailOptions.Headers,
+		"email", *findUserByEmailOptions.Email,
+		func(index *userIndex) (UserDetails, bool) {
+			user, ok := index.byEmail[email]
+			return user, ok
+		},
+		func(user *UserDetails) bool {
+			return strings.ToLower(core.StringNilMapper(user.Email)) == email
+		})
+}
+
+// FindUserByName : Find a user by shortname
+// Pages through the users of the service instance until one with the shortname is found. Returns an
+// error wrapping a *NotFoundError if there is none.
+func (mqcloud *MqcloudV1) FindUserByName(findUserByNameOptions *FindUserByNameOptions) (result *UserDetails, err error) {
+	result, err = mqcloud.FindUserByNameWithContext(context.Background(), findUserByNameOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// FindUserByNameWithContext is an alternate form of the FindUserByName method which supports a Context parameter
+func (mqcloud *MqcloudV1) FindUserByNameWithContext(ctx context.Context, findUserByNameOptions *FindUserByNameOptions) (result *UserDetails, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "FindUserByName", QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuidOf(findUserByNameOptions)})
+	defer func() {
+		end(err)
+	}()
+
+	err = validateFindOptions(findUserByNameOptions, "findUserByNameOptions")
+	if err != nil {
+		return
+	}
+	name := *findUserByNameOptions.Name
+	return mqcloud.findUser(ctx, findUserByNameOptions.ServiceInstanceGuid, findUserByNameOptions.Cache, findUserByNameOptions.Headers,
+		"name", name,
+		func(index *userIndex) (UserDetails, bool) {
+			user, ok := index.byName[name]
+			return user, ok
+		},
+		func(user *UserDetails) bool {
+			return core.StringNilMapper(user.Name) == name
+		})
+}
+
+// FindApplicationByName : Find an application by name
+// Pages through the applications of the service instance until one with the name is found. Returns
+// an error wrapping a *NotFoundError if there is none.
+func (mqcloud *MqcloudV1) FindApplicationByName(findApplicationByNameOptions *FindApplicationByNameOptions) (result *ApplicationDetails, err error) {
+	result, err = mqcloud.FindApplicationByNameWithContext(context.Background(), findApplicationByNameOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// FindApplicationByNameWithContext is an alternate form of the FindApplicationByName method which supports a Context parameter
+func (mqcloud *MqcloudV1) FindApplicationByNameWithContext(ctx context.Context, findApplicationByNameOptions *FindApplicationByNameOptions) (result *ApplicationDetails, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "FindApplicationByName", QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuidOf(findApplicationByNameOptions)})
+	defer func() {
+		end(err)
+	}()
+
+	err = validateFindOptions(findApplicationByNameOptions, "findApplicationByNameOptions")
+	if err != nil {
+		return
+	}
+	guid := *findApplicationByNameOptions.ServiceInstanceGuid
+	name := *findApplicationByNameOptions.Name
+	notFound := func() error {
+		return core.SDKErrorf(&NotFoundError{Resource: "application", Field: "name", Value: name, ServiceInstanceGuid: guid}, "", "application-not-found", common.GetComponentInfo())
+	}
+
+	pager, err := mqcloud.NewApplicationsPager(&ListApplicationsOptions{
+		ServiceInstanceGuid: findApplicationByNameOptions.ServiceInstanceGuid,
+		Headers:             findApplicationByNameOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+
+	if cache := findApplicationByNameOptions.Cache; cache != nil {
+		var cached interface{}
+		cached, err = cache.load(ctx, "applications/"+guid, func() (interface{}, error) {
+			applications, err := pager.GetAllWithContext(ctx)
+			if err != nil {
+				return nil, core.RepurposeSDKProblem(err, "application-list-error")
+			}
+			index := &applicationIndex{byName: make(map[string]ApplicationDetails)}
+			for _, application := range applications {
+				index.byName[core.StringNilMapper(application.Name)] = application
+			}
+			return index, nil
+		})
+		if err != nil {
+			return
+		}
+		if application, ok := cached.(*applicationIndex).byName[name]; ok {
+			return &application, nil
+		}
+		return nil, notFound()
+	}
+
+	for pager.HasNext() {
+		var page []ApplicationDetails
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "application-list-error")
+			return
+		}
+		for i := range page {
+			if core.StringNilMapper(page[i].Name) == name {
+				return &page[i], nil
+			}
+		}
+	}
+	return nil, notFound()
+}
+
+// findUser looks a user up in the cache, if there is one, or pages through the users until match
+// returns true.
+func (mqcloud *MqcloudV1) findUser(ctx context.Context, serviceInstanceGuid *string, cache *LookupCache, headers map[string]string,
+	field string, value string, lookup func(*userIndex) (UserDetails, bool), match func(*UserDetails) bool) (result *UserDetails, err error) {
+	guid := *serviceInstanceGuid
+	notFound := func() error {
+		return core.SDKErrorf(&NotFoundError{Resource: "user", Field: field, Value: value, ServiceInstanceGuid: guid}, "", "user-not-found", common.GetComponentInfo())
+	}
+
+	pager, err := mqcloud.NewUsersPager(&ListUsersOptions{
+		ServiceInstanceGuid: serviceInstanceGuid,
+		Headers:             headers,
+	})
+	if err != nil {
+		return
+	}
+
+	if cache != nil {
+		var cached interface{}
+		cached, err = cache.load(ctx, "users/"+guid, func() (interface{}, error) {
+			users, err := pager.GetAllWithContext(ctx)
+			if err != nil {
+				return nil, core.RepurposeSDKProblem(err, "user-list-error")
+			}
+			index := &userIndex{byEmail: make(map[string]UserDetails), byName: make(map[string]UserDetails)}
+			for _, user := range users {
+				index.byEmail[strings.ToLower(core.StringNilMapper(user.Email))] = user
+				index.byName[core.StringNilMapper(user.Name)] = user
+			}
+			return index, nil
+		})
+		if err != nil {
+			return
+		}
+		if user, ok := lookup(cached.(*userIndex)); ok {
+			return &user, nil
+		}
+		return nil, notFound()
+	}
+
+	for pager.HasNext() {
+		var page []UserDetails
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "user-list-error")
+			return
+		}
+		for i := range page {
+			if match(&page[i]) {
+				return &page[i], nil
+			}
+		}
+	}
+	return nil, notFound()
+}
+
+// expired returns true if an index fetched at the given time is too old to use.
+func (cache *LookupCache) expired(fetched time.Time) bool {
+	return !cache.now().Before(fetched.Add(cache.ttl))
+}
+
+// validateFindOptions applies the nil and struct validation every Find method starts with.
+func validateFindOptions(options interface{}, name string) (err error) {
+	err = core.ValidateNotNil(options, name+" cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	err = core.ValidateStruct(options, name)
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Lookups`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		server         *userTestServer
+		mqcloudService *mqcloudv1.MqcloudV1
+	)
+
+	BeforeEach(func() {
+		server = newUserTestServer(serviceInstanceGuid,
+			[2]string{"alice@example.com", "alice"},
+			[2]string{"bob@example.com", "bob"},
+			[2]string{"carol@example.com", "carol"},
+			[2]string{"dave@example.com", "dave"},
+			[2]string{"erin@example.com", "erin"})
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Finds users by email and name, stopping at the first match`, func() {
+		user, err := mqcloudService.FindUserByEmail(mqcloudService.NewFindUserByEmailOptions(serviceInstanceGuid, "Bob@Example.com"))
+		Expect(err).To(BeNil())
+		Expect(*user.ID).To(Equal("user02"))
+		Expect(server.listCalls).To(Equal(1))
+
+		user, err = mqcloudService.FindUserByName(mqcloudService.NewFindUserByNameOptions(serviceInstanceGuid, "erin"))
+		Expect(err).To(BeNil())
+		Expect(*user.Email).To(Equal("erin@example.com"))
+		Expect(server.listCalls).To(Equal(4))
+	})
+	It(`Returns a typed not-found error`, func() {
+		user, err := mqcloudService.FindUserByName(mqcloudService.NewFindUserByNameOptions(serviceInstanceGuid, "zed"))
+		Expect(user).To(BeNil())
+		var notFound *mqcloudv1.NotFoundError
+		Expect(errors.As(err, &notFound)).To(BeTrue())
+		Expect(notFound.Resource).To(Equal("user"))
+		Expect(notFound.Field).To(Equal("name"))
+		Expect(notFound.Value).To(Equal("zed"))
+		Expect(err.Error()).To(ContainSubstring("zed"))
+	})
+	It(`Reuses a cached index until it expires or is invalidated`, func() {
+		cache := mqcloudv1.NewLookupCache(time.Hour)
+		for _, email := range []string{"alice@example.com", "erin@example.com", "nobody@example.com"} {
+			_, _ = mqcloudService.FindUserByEmail(mqcloudService.NewFindUserByEmailOptions(serviceInstanceGuid, email).SetCache(cache))
+		}
+		user, err := mqcloudService.FindUserByName(mqcloudService.NewFindUserByNameOptions(serviceInstanceGuid, "carol").SetCache(cache))
+		Expect(err).To(BeNil())
+		Expect(*user.ID).To(Equal("user03"))
+		Expect(server.listCalls).To(Equal(3))
+
+		cache.Invalidate()
+		_, err = mqcloudService.FindUserByName(mqcloudService.NewFindUserByNameOptions(serviceInstanceGuid, "carol").SetCache(cache))
+		Expect(err).To(BeNil())
+		Expect(server.listCalls).To(Equal(6))
+
+		shortLived := mqcloudv1.NewLookupCache(time.Millisecond)
+		_, _ = mqcloudService.FindUserByName(mqcloudService.NewFindUserByNameOptions(serviceInstanceGuid, "alice").SetCache(shortLived))
+		time.Sleep(5 * time.Millisecond)
+		_, _ = mqcloudService.FindUserByName(mqcloudService.NewFindUserByNameOptions(serviceInstanceGuid, "alice").SetCache(shortLived))
+		Expect(server.listCalls).To(Equal(12))
+	})
+
+	Describe(`FindApplicationByName`, func() {
+		var (
+			appServer *httptest.Server
+			appCalls  int
+		)
+
+		BeforeEach(func() {
+			appCalls = 0
+			appServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				Expect(req.URL.Path).To(Equal("/v1/" + serviceInstanceGuid + "/applications"))
+				appCalls++
+				res.Header().Set("Content-type", "application/json")
+				offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+				if offset == 0 {
+					fmt.Fprintf(res, `{"applications":[{"id":"a1","name":"orders"}],"next":{"href":"%s?offset=1"}}`, req.URL.Path)
+				} else {
+					fmt.Fprint(res, `{"applications":[{"id":"a2","name":"payments"}]}`)
+				}
+			}))
+			Expect(mqcloudService.SetServiceURL(appServer.URL)).To(Succeed())
+		})
+		AfterEach(func() {
+			appServer.Close()
+		})
+
+		It(`Finds applications by name`, func() {
+			cache := mqcloudv1.NewLookupCache(time.Minute)
+			application, err := mqcloudService.FindApplicationByName(mqcloudService.NewFindApplicationByNameOptions(serviceInstanceGuid, "payments").SetCache(cache))
+			Expect(err).To(BeNil())
+			Expect(*application.ID).To(Equal("a2"))
+			application, err = mqcloudService.FindApplicationByName(mqcloudService.NewFindApplicationByNameOptions(serviceInstanceGuid, "orders").SetCache(cache))
+			Expect(err).To(BeNil())
+			Expect(*application.ID).To(Equal("a1"))
+			Expect(appCalls).To(Equal(2))
+
+			_, err = mqcloudService.FindApplicationByName(mqcloudService.NewFindApplicationByNameOptions(serviceInstanceGuid, "billing"))
+			var notFound *mqcloudv1.NotFoundError
+			Expect(errors.As(err, &notFound)).To(BeTrue())
+			Expect(notFound.Resource).To(Equal("application"))
+		})
+		It(`Fetches without holding up lookups in other service instances`, func() {
+			const otherGuid = "0ba1e2bc-2a07-4cb8-9e9b-1e4a2bb13f6c"
+			var slowCalls atomic.Int32
+			arrived := make(chan struct{}, 2)
+			release := make(chan struct{})
+			appServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				if req.URL.Path == "/v1/"+serviceInstanceGuid+"/applications" {
+					slowCalls.Add(1)
+					arrived <- struct{}{}
+					<-release
+				}
+				fmt.Fprint(res, `{"applications":[{"id":"a1","name":"orders"}]}`)
+			})
+
+			cache := mqcloudv1.NewLookupCache(time.Minute)
+			results := make(chan error, 2)
+			for i := 0; i < 2; i++ {
+				go func() {
+					_, err := mqcloudService.FindApplicationByName(mqcloudService.NewFindApplicationByNameOptions(serviceInstanceGuid, "orders").SetCache(cache))
+					results <- err
+				}()
+			}
+			Eventually(arrived).Should(Receive())
+
+			application, err := mqcloudService.FindApplicationByName(mqcloudService.NewFindApplicationByNameOptions(otherGuid, "orders").SetCache(cache))
+			Expect(err).To(BeNil())
+			Expect(*application.ID).To(Equal("a1"))
+
+			close(release)
+			Eventually(results).Should(Receive(BeNil()))
+			Eventually(results).Should(Receive(BeNil()))
+			Expect(slowCalls.Load()).To(Equal(int32(1)))
+		})
+	})
+})
@@ -0,0 +1,78 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"strconv"
+	"time"
+)
+
+// OperationObservation : The measurements of one call of an operation.
+type OperationObservation struct {
+	// The operation ID passed to common.GetSdkHeaders, such as "CreateQueueManager".
+	OperationID string
+
+	// How long the call took, including retries, waits and interceptors.
+	Duration time.Duration
+
+	// The HTTP status code of the final response, or 0 if there was none.
+	StatusCode int
+
+	// The number of times the HTTP client retried the request.
+	Retries int
+
+	// The error of the call, or nil.
+	Err error
+}
+
+// Metrics : Receives measurements of the operations a client makes.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// ObserveOperation is called once for every call of an operation, when it returns.
+	ObserveOperation(observation OperationObservation)
+
+	// ObservePage is called each time a pager fetches a page. The operation ID is that of the list
+	// operation, such as "ListUsers".
+	ObservePage(operationID string)
+}
+
+// SetMetrics sets the metrics the client reports its operations to. Calling it with nil stops the
+// reporting. The metrics are shared with clones made afterwards.
+func (mqcloud *MqcloudV1) SetMetrics(metrics Metrics) {
+	mqcloud.metrics = metrics
+}
+
+// GetMetrics returns the metrics set with SetMetrics, or nil.
+func (mqcloud *MqcloudV1) GetMetrics() Metrics {
+	return mqcloud.metrics
+}
+
+// StatusClass returns the class of an HTTP status code, such as "2xx" or "4xx", for use as a metric
+// label. It returns "none" for 0, when no response was received.
+func StatusClass(statusCode int) string {
+	if statusCode < 100 || statusCode > 599 {
+		return "none"
+	}
+	return strconv.Itoa(statusCode/100) + "xx"
+}
+
+// observePage reports a page fetched by a pager.
+func (mqcloud *MqcloudV1) observePage(operationID string) {
+	if mqcloud.metrics != nil {
+		mqcloud.metrics.ObservePage(operationID)
+	}
+}
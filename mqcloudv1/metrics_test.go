@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// recordingMetrics keeps what it is told.
+type recordingMetrics struct {
+	mutex        sync.Mutex
+	observations []mqcloudv1.OperationObservation
+	pages        []string
+}
+
+func (metrics *recordingMetrics) ObserveOperation(observation mqcloudv1.OperationObservation) {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	metrics.observations = append(metrics.observations, observation)
+}
+
+func (metrics *recordingMetrics) ObservePage(operationID string) {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	metrics.pages = append(metrics.pages, operationID)
+}
+
+var _ = Describe(`Metrics`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		metrics        *recordingMetrics
+	)
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Path == "/v1/"+serviceInstanceGuid+"/applications" {
+				fmt.Fprint(res, `{"limit":25,"offset":0,"total_count":1,"applications":[{"id":"a1","name":"app1"}]}`)
+				return
+			}
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"errors":[{"code":"not_found","message":"no such queue manager"}]}`)
+		}))
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		metrics = &recordingMetrics{}
+		mqcloudService.SetMetrics(metrics)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Observes every call and every page`, func() {
+		_, _, err := mqcloudService.GetQueueManager(&mqcloudv1.GetQueueManagerOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			QueueManagerID:      core.StringPtr("missing"),
+		})
+		Expect(err).ToNot(BeNil())
+		pager, err := mqcloudService.NewApplicationsPager(&mqcloudv1.ListApplicationsOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		})
+		Expect(err).To(BeNil())
+		_, err = pager.GetAll()
+		Expect(err).To(BeNil())
+
+		Expect(metrics.observations).To(HaveLen(2))
+		Expect(metrics.observations[0].OperationID).To(Equal("GetQueueManager"))
+		Expect(metrics.observations[0].StatusCode).To(Equal(404))
+		Expect(metrics.observations[0].Err).ToNot(BeNil())
+		Expect(metrics.observations[0].Duration).To(BeNumerically(">", 0))
+		Expect(metrics.observations[1].OperationID).To(Equal("ListApplications"))
+		Expect(metrics.observations[1].StatusCode).To(Equal(200))
+		Expect(metrics.observations[1].Err).To(BeNil())
+		Expect(metrics.pages).To(Equal([]string{"ListApplications"}))
+
+		Expect(mqcloudService.Clone().GetMetrics()).To(BeIdenticalTo(metrics))
+		mqcloudService.SetMetrics(nil)
+		_, _, err = mqcloudService.ListApplications(&mqcloudv1.ListApplicationsOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		})
+		Expect(err).To(BeNil())
+		Expect(metrics.observations).To(HaveLen(2))
+	})
+	It(`Classifies status codes`, func() {
+		Expect(mqcloudv1.StatusClass(0)).To(Equal("none"))
+		Expect(mqcloudv1.StatusClass(201)).To(Equal("2xx"))
+		Expect(mqcloudv1.StatusClass(429)).To(Equal("4xx"))
+		Expect(mqcloudv1.StatusClass(503)).To(Equal("5xx"))
+	})
+})
@@ -44,6 +44,27 @@ type MqcloudV1 struct {
 
 	// The acceptable list of languages supported in the client.
 	AcceptLanguage *string
+
+	// Retry policies set with SetRetryPolicy, by operation ID.
+	retryPolicies map[string]RetryPolicy
+
+	// The settings from EnableTransitionRetries, or nil.
+	transitionRetry *TransitionRetryOptions
+
+	// The rate limiter set with SetRateLimiter, shared with clones, or nil.
+	rateLimiter *RateLimiter
+
+	// The coordinator set with SetQueueManagerCoordinator, shared with clones, or nil.
+	coordinator *QueueManagerCoordinator
+
+	// The interceptors every operation runs through, outermost first.
+	interceptors []Interceptor
+
+	// The tracer set with SetTracer, shared with clones, or nil.
+	tracer Tracer
+
+	// The metrics set with SetMetrics, shared with clones, or nil.
+	metrics Metrics
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -93,6 +114,7 @@ func NewMqcloudV1UsingExternalConfig(options *MqcloudV1Options) (mqcloud *Mqclou
 		err = core.SDKErrorf(err, "", "client-config-error", common.GetComponentInfo())
 		return
 	}
+	mqcloud.installRetryPolicy()
 
 	if options.URL != "" {
 		err = mqcloud.Service.SetServiceURL(options.URL)
@@ -187,8 +209,10 @@ func (mqcloud *MqcloudV1) GetEnableGzipCompression() bool {
 
 // EnableRetries enables automatic retries for requests invoked for this service instance.
 // If either parameter is specified as 0, then a default value is used instead.
+// Each operation is retried according to its RetryPolicy; see SetRetryPolicy.
 func (mqcloud *MqcloudV1) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
 	mqcloud.Service.EnableRetries(maxRetries, maxRetryInterval)
+	mqcloud.installRetryPolicy()
 }
 
 // DisableRetries disables automatic retries for requests invoked for this service instance.
@@ -250,10 +274,10 @@ func (mqcloud *MqcloudV1) GetUsageDetailsWithContext(ctx context.Context, getUsa
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetUsageDetails", getUsageDetailsOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_usage_details", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -322,10 +346,10 @@ func (mqcloud *MqcloudV1) GetOptionsWithContext(ctx context.Context, getOptionsO
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetOptions", getOptionsOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_options", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -417,10 +441,10 @@ func (mqcloud *MqcloudV1) CreateQueueManagerWithContext(ctx context.Context, cre
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("CreateQueueManager", createQueueManagerOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_queue_manager", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -496,10 +520,10 @@ func (mqcloud *MqcloudV1) ListQueueManagersWithContext(ctx context.Context, list
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("ListQueueManagers", listQueueManagersOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_queue_managers", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -569,10 +593,10 @@ func (mqcloud *MqcloudV1) GetQueueManagerWithContext(ctx context.Context, getQue
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetQueueManager", getQueueManagerOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_queue_manager", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -642,10 +666,10 @@ func (mqcloud *MqcloudV1) DeleteQueueManagerWithContext(ctx context.Context, del
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("DeleteQueueManager", deleteQueueManagerOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_queue_manager", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -726,10 +750,10 @@ func (mqcloud *MqcloudV1) SetQueueManagerVersionWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("SetQueueManagerVersion", setQueueManagerVersionOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_queue_manager_version", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -799,10 +823,10 @@ func (mqcloud *MqcloudV1) GetQueueManagerAvailableUpgradeVersionsWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetQueueManagerAvailableUpgradeVersions", getQueueManagerAvailableUpgradeVersionsOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_queue_manager_available_upgrade_versions", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -872,10 +896,10 @@ func (mqcloud *MqcloudV1) GetQueueManagerConnectionInfoWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetQueueManagerConnectionInfo", getQueueManagerConnectionInfoOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_queue_manager_connection_info", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -945,10 +969,10 @@ func (mqcloud *MqcloudV1) GetQueueManagerStatusWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetQueueManagerStatus", getQueueManagerStatusOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_queue_manager_status", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1024,10 +1048,10 @@ func (mqcloud *MqcloudV1) ListUsersWithContext(ctx context.Context, listUsersOpt
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("ListUsers", listUsersOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_users", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1110,10 +1134,10 @@ func (mqcloud *MqcloudV1) CreateUserWithContext(ctx context.Context, createUserO
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("CreateUser", createUserOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_user", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1183,10 +1207,10 @@ func (mqcloud *MqcloudV1) GetUserWithContext(ctx context.Context, getUserOptions
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetUser", getUserOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_user", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1254,10 +1278,10 @@ func (mqcloud *MqcloudV1) DeleteUserWithContext(ctx context.Context, deleteUserO
 		return
 	}
 
-	response, err = mqcloud.Service.Request(request, nil)
+	response, err = mqcloud.request("DeleteUser", deleteUserOptions, request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_user", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1325,10 +1349,10 @@ func (mqcloud *MqcloudV1) ListApplicationsWithContext(ctx context.Context, listA
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("ListApplications", listApplicationsOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_applications", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1408,15 +1432,17 @@ func (mqcloud *MqcloudV1) CreateApplicationWithContext(ctx context.Context, crea
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("CreateApplication", createApplicationOptions, request, &rawResponse)
 	if err != nil {
+		redactAPIKey(response)
 		core.EnrichHTTPProblem(err, "create_application", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
 		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalApplicationCreated)
 		if err != nil {
+			redactAPIKey(response)
 			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
 			return
 		}
@@ -1481,10 +1507,10 @@ func (mqcloud *MqcloudV1) GetApplicationWithContext(ctx context.Context, getAppl
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetApplication", getApplicationOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_application", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1552,10 +1578,10 @@ func (mqcloud *MqcloudV1) DeleteApplicationWithContext(ctx context.Context, dele
 		return
 	}
 
-	response, err = mqcloud.Service.Request(request, nil)
+	response, err = mqcloud.request("DeleteApplication", deleteApplicationOptions, request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_application", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1628,15 +1654,17 @@ func (mqcloud *MqcloudV1) CreateApplicationApikeyWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("CreateApplicationApikey", createApplicationApikeyOptions, request, &rawResponse)
 	if err != nil {
+		redactAPIKey(response)
 		core.EnrichHTTPProblem(err, "create_application_apikey", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
 		err = core.UnmarshalModel(rawResponse, "", &result, UnmarshalApplicationAPIKeyCreated)
 		if err != nil {
+			redactAPIKey(response)
 			err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
 			return
 		}
@@ -1705,10 +1733,10 @@ func (mqcloud *MqcloudV1) CreateTrustStorePemCertificateWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("CreateTrustStorePemCertificate", createTrustStorePemCertificateOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_trust_store_pem_certificate", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1778,10 +1806,10 @@ func (mqcloud *MqcloudV1) ListTrustStoreCertificatesWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("ListTrustStoreCertificates", listTrustStoreCertificatesOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_trust_store_certificates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1852,10 +1880,10 @@ func (mqcloud *MqcloudV1) GetTrustStoreCertificateWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetTrustStoreCertificate", getTrustStoreCertificateOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_trust_store_certificate", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1924,10 +1952,10 @@ func (mqcloud *MqcloudV1) DeleteTrustStoreCertificateWithContext(ctx context.Con
 		return
 	}
 
-	response, err = mqcloud.Service.Request(request, nil)
+	response, err = mqcloud.request("DeleteTrustStoreCertificate", deleteTrustStoreCertificateOptions, request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_trust_store_certificate", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -1989,10 +2017,10 @@ func (mqcloud *MqcloudV1) DownloadTrustStoreCertificateWithContext(ctx context.C
 		return
 	}
 
-	response, err = mqcloud.Service.Request(request, &result)
+	response, err = mqcloud.request("DownloadTrustStoreCertificate", downloadTrustStoreCertificateOptions, request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "download_trust_store_certificate", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2058,10 +2086,10 @@ func (mqcloud *MqcloudV1) CreateKeyStorePemCertificateWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("CreateKeyStorePemCertificate", createKeyStorePemCertificateOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_key_store_pem_certificate", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2131,10 +2159,10 @@ func (mqcloud *MqcloudV1) ListKeyStoreCertificatesWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("ListKeyStoreCertificates", listKeyStoreCertificatesOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_key_store_certificates", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2205,10 +2233,10 @@ func (mqcloud *MqcloudV1) GetKeyStoreCertificateWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetKeyStoreCertificate", getKeyStoreCertificateOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_key_store_certificate", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2277,10 +2305,10 @@ func (mqcloud *MqcloudV1) DeleteKeyStoreCertificateWithContext(ctx context.Conte
 		return
 	}
 
-	response, err = mqcloud.Service.Request(request, nil)
+	response, err = mqcloud.request("DeleteKeyStoreCertificate", deleteKeyStoreCertificateOptions, request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_key_store_certificate", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2342,10 +2370,10 @@ func (mqcloud *MqcloudV1) DownloadKeyStoreCertificateWithContext(ctx context.Con
 		return
 	}
 
-	response, err = mqcloud.Service.Request(request, &result)
+	response, err = mqcloud.request("DownloadKeyStoreCertificate", downloadKeyStoreCertificateOptions, request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "download_key_store_certificate", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2408,10 +2436,10 @@ func (mqcloud *MqcloudV1) GetCertificateAmsChannelsWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("GetCertificateAmsChannels", getCertificateAmsChannelsOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_certificate_ams_channels", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2496,10 +2524,10 @@ func (mqcloud *MqcloudV1) SetCertificateAmsChannelsWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = mqcloud.Service.Request(request, &rawResponse)
+	response, err = mqcloud.request("SetCertificateAmsChannels", setCertificateAmsChannelsOptions, request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_certificate_ams_channels", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2525,8 +2553,9 @@ type ApplicationAPIKeyCreated struct {
 	// The id of the api key.
 	ApiKeyID *string `json:"api_key_id" validate:"required"`
 
-	// The api key created.
-	ApiKey *string `json:"api_key" validate:"required"`
+	// The api key created. It is redacted when printed, and json.Marshal writes it as Redacted, so a
+	// marshalled copy of this model cannot be used to recover the key; use Reveal to read or store it.
+	ApiKey *Secret `json:"api_key" validate:"required"`
 }
 
 // UnmarshalApplicationAPIKeyCreated unmarshals an instance of ApplicationAPIKeyCreated from the specified map of raw messages.
@@ -2571,8 +2600,9 @@ type ApplicationCreated struct {
 	// The id of the api key.
 	ApiKeyID *string `json:"api_key_id,omitempty"`
 
-	// The api key created.
-	ApiKey *string `json:"api_key" validate:"required"`
+	// The api key created. It is redacted when printed, and json.Marshal writes it as Redacted, so a
+	// marshalled copy of this model cannot be used to recover the key; use Reveal to read or store it.
+	ApiKey *Secret `json:"api_key" validate:"required"`
 }
 
 // UnmarshalApplicationCreated unmarshals an instance of ApplicationCreated from the specified map of raw messages.
@@ -5289,6 +5319,7 @@ func (pager *QueueManagersPager) GetNextWithContext(ctx context.Context) (page [
 	pager.pageContext.next = next
 	pager.hasNext = (pager.pageContext.next != nil)
 	page = result.QueueManagers
+	pager.client.observePage("ListQueueManagers")
 
 	return
 }
@@ -5381,6 +5412,7 @@ func (pager *UsersPager) GetNextWithContext(ctx context.Context) (page []UserDet
 	pager.pageContext.next = next
 	pager.hasNext = (pager.pageContext.next != nil)
 	page = result.Users
+	pager.client.observePage("ListUsers")
 
 	return
 }
@@ -5473,6 +5505,7 @@ func (pager *ApplicationsPager) GetNextWithContext(ctx context.Context) (page []
 	pager.pageContext.next = next
 	pager.hasNext = (pager.pageContext.next != nil)
 	page = result.Applications
+	pager.client.observePage("ListApplications")
 
 	return
 }
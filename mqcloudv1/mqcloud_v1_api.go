@@ -0,0 +1,95 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MqcloudV1API : The operations of the MQ on Cloud API, in both their plain and WithContext forms.
+// Depend on this interface rather than *MqcloudV1 so that tests can substitute a fake, such as
+// FakeMqcloudV1API in package mqcloudv1fakes. Both *MqcloudV1 and *ServiceInstance implement it.
+type MqcloudV1API interface {
+	GetUsageDetails(getUsageDetailsOptions *GetUsageDetailsOptions) (result *Usage, response *core.DetailedResponse, err error)
+	GetUsageDetailsWithContext(ctx context.Context, getUsageDetailsOptions *GetUsageDetailsOptions) (result *Usage, response *core.DetailedResponse, err error)
+	GetOptions(getOptionsOptions *GetOptionsOptions) (result *ConfigurationOptions, response *core.DetailedResponse, err error)
+	GetOptionsWithContext(ctx context.Context, getOptionsOptions *GetOptionsOptions) (result *ConfigurationOptions, response *core.DetailedResponse, err error)
+	CreateQueueManager(createQueueManagerOptions *CreateQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error)
+	CreateQueueManagerWithContext(ctx context.Context, createQueueManagerOptions *CreateQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error)
+	ListQueueManagers(listQueueManagersOptions *ListQueueManagersOptions) (result *QueueManagerDetailsCollection, response *core.DetailedResponse, err error)
+	ListQueueManagersWithContext(ctx context.Context, listQueueManagersOptions *ListQueueManagersOptions) (result *QueueManagerDetailsCollection, response *core.DetailedResponse, err error)
+	GetQueueManager(getQueueManagerOptions *GetQueueManagerOptions) (result *QueueManagerDetails, response *core.DetailedResponse, err error)
+	GetQueueManagerWithContext(ctx context.Context, getQueueManagerOptions *GetQueueManagerOptions) (result *QueueManagerDetails, response *core.DetailedResponse, err error)
+	DeleteQueueManager(deleteQueueManagerOptions *DeleteQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error)
+	DeleteQueueManagerWithContext(ctx context.Context, deleteQueueManagerOptions *DeleteQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error)
+	SetQueueManagerVersion(setQueueManagerVersionOptions *SetQueueManagerVersionOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error)
+	SetQueueManagerVersionWithContext(ctx context.Context, setQueueManagerVersionOptions *SetQueueManagerVersionOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error)
+	GetQueueManagerAvailableUpgradeVersions(getQueueManagerAvailableUpgradeVersionsOptions *GetQueueManagerAvailableUpgradeVersionsOptions) (result *QueueManagerVersionUpgrades, response *core.DetailedResponse, err error)
+	GetQueueManagerAvailableUpgradeVersionsWithContext(ctx context.Context, getQueueManagerAvailableUpgradeVersionsOptions *GetQueueManagerAvailableUpgradeVersionsOptions) (result *QueueManagerVersionUpgrades, response *core.DetailedResponse, err error)
+	GetQueueManagerConnectionInfo(getQueueManagerConnectionInfoOptions *GetQueueManagerConnectionInfoOptions) (result *ConnectionInfo, response *core.DetailedResponse, err error)
+	GetQueueManagerConnectionInfoWithContext(ctx context.Context, getQueueManagerConnectionInfoOptions *GetQueueManagerConnectionInfoOptions) (result *ConnectionInfo, response *core.DetailedResponse, err error)
+	GetQueueManagerStatus(getQueueManagerStatusOptions *GetQueueManagerStatusOptions) (result *QueueManagerStatus, response *core.DetailedResponse, err error)
+	GetQueueManagerStatusWithContext(ctx context.Context, getQueueManagerStatusOptions *GetQueueManagerStatusOptions) (result *QueueManagerStatus, response *core.DetailedResponse, err error)
+	ListUsers(listUsersOptions *ListUsersOptions) (result *UserDetailsCollection, response *core.DetailedResponse, err error)
+	ListUsersWithContext(ctx context.Context, listUsersOptions *ListUsersOptions) (result *UserDetailsCollection, response *core.DetailedResponse, err error)
+	CreateUser(createUserOptions *CreateUserOptions) (result *UserDetails, response *core.DetailedResponse, err error)
+	CreateUserWithContext(ctx context.Context, createUserOptions *CreateUserOptions) (result *UserDetails, response *core.DetailedResponse, err error)
+	GetUser(getUserOptions *GetUserOptions) (result *UserDetails, response *core.DetailedResponse, err error)
+	GetUserWithContext(ctx context.Context, getUserOptions *GetUserOptions) (result *UserDetails, response *core.DetailedResponse, err error)
+	DeleteUser(deleteUserOptions *DeleteUserOptions) (response *core.DetailedResponse, err error)
+	DeleteUserWithContext(ctx context.Context, deleteUserOptions *DeleteUserOptions) (response *core.DetailedResponse, err error)
+	ListApplications(listApplicationsOptions *ListApplicationsOptions) (result *ApplicationDetailsCollection, response *core.DetailedResponse, err error)
+	ListApplicationsWithContext(ctx context.Context, listApplicationsOptions *ListApplicationsOptions) (result *ApplicationDetailsCollection, response *core.DetailedResponse, err error)
+	CreateApplication(createApplicationOptions *CreateApplicationOptions) (result *ApplicationCreated, response *core.DetailedResponse, err error)
+	CreateApplicationWithContext(ctx context.Context, createApplicationOptions *CreateApplicationOptions) (result *ApplicationCreated, response *core.DetailedResponse, err error)
+	GetApplication(getApplicationOptions *GetApplicationOptions) (result *ApplicationDetails, response *core.DetailedResponse, err error)
+	GetApplicationWithContext(ctx context.Context, getApplicationOptions *GetApplicationOptions) (result *ApplicationDetails, response *core.DetailedResponse, err error)
+	DeleteApplication(deleteApplicationOptions *DeleteApplicationOptions) (response *core.DetailedResponse, err error)
+	DeleteApplicationWithContext(ctx context.Context, deleteApplicationOptions *DeleteApplicationOptions) (response *core.DetailedResponse, err error)
+	CreateApplicationApikey(createApplicationApikeyOptions *CreateApplicationApikeyOptions) (result *ApplicationAPIKeyCreated, response *core.DetailedResponse, err error)
+	CreateApplicationApikeyWithContext(ctx context.Context, createApplicationApikeyOptions *CreateApplicationApikeyOptions) (result *ApplicationAPIKeyCreated, response *core.DetailedResponse, err error)
+	CreateTrustStorePemCertificate(createTrustStorePemCertificateOptions *CreateTrustStorePemCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error)
+	CreateTrustStorePemCertificateWithContext(ctx context.Context, createTrustStorePemCertificateOptions *CreateTrustStorePemCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error)
+	ListTrustStoreCertificates(listTrustStoreCertificatesOptions *ListTrustStoreCertificatesOptions) (result *TrustStoreCertificateDetailsCollection, response *core.DetailedResponse, err error)
+	ListTrustStoreCertificatesWithContext(ctx context.Context, listTrustStoreCertificatesOptions *ListTrustStoreCertificatesOptions) (result *TrustStoreCertificateDetailsCollection, response *core.DetailedResponse, err error)
+	GetTrustStoreCertificate(getTrustStoreCertificateOptions *GetTrustStoreCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error)
+	GetTrustStoreCertificateWithContext(ctx context.Context, getTrustStoreCertificateOptions *GetTrustStoreCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error)
+	DeleteTrustStoreCertificate(deleteTrustStoreCertificateOptions *DeleteTrustStoreCertificateOptions) (response *core.DetailedResponse, err error)
+	DeleteTrustStoreCertificateWithContext(ctx context.Context, deleteTrustStoreCertificateOptions *DeleteTrustStoreCertificateOptions) (response *core.DetailedResponse, err error)
+	DownloadTrustStoreCertificate(downloadTrustStoreCertificateOptions *DownloadTrustStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	DownloadTrustStoreCertificateWithContext(ctx context.Context, downloadTrustStoreCertificateOptions *DownloadTrustStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	CreateKeyStorePemCertificate(createKeyStorePemCertificateOptions *CreateKeyStorePemCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error)
+	CreateKeyStorePemCertificateWithContext(ctx context.Context, createKeyStorePemCertificateOptions *CreateKeyStorePemCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error)
+	ListKeyStoreCertificates(listKeyStoreCertificatesOptions *ListKeyStoreCertificatesOptions) (result *KeyStoreCertificateDetailsCollection, response *core.DetailedResponse, err error)
+	ListKeyStoreCertificatesWithContext(ctx context.Context, listKeyStoreCertificatesOptions *ListKeyStoreCertificatesOptions) (result *KeyStoreCertificateDetailsCollection, response *core.DetailedResponse, err error)
+	GetKeyStoreCertificate(getKeyStoreCertificateOptions *GetKeyStoreCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error)
+	GetKeyStoreCertificateWithContext(ctx context.Context, getKeyStoreCertificateOptions *GetKeyStoreCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error)
+	DeleteKeyStoreCertificate(deleteKeyStoreCertificateOptions *DeleteKeyStoreCertificateOptions) (response *core.DetailedResponse, err error)
+	DeleteKeyStoreCertificateWithContext(ctx context.Context, deleteKeyStoreCertificateOptions *DeleteKeyStoreCertificateOptions) (response *core.DetailedResponse, err error)
+	DownloadKeyStoreCertificate(downloadKeyStoreCertificateOptions *DownloadKeyStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	DownloadKeyStoreCertificateWithContext(ctx context.Context, downloadKeyStoreCertificateOptions *DownloadKeyStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+	GetCertificateAmsChannels(getCertificateAmsChannelsOptions *GetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error)
+	GetCertificateAmsChannelsWithContext(ctx context.Context, getCertificateAmsChannelsOptions *GetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error)
+	SetCertificateAmsChannels(setCertificateAmsChannelsOptions *SetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error)
+	SetCertificateAmsChannelsWithContext(ctx context.Context, setCertificateAmsChannelsOptions *SetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error)
+}
+
+var _ MqcloudV1API = (*MqcloudV1)(nil)
+var _ MqcloudV1API = (*ServiceInstance)(nil)
@@ -21,11 +21,14 @@ package mqcloudv1_test
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
 	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/hashicorp/go-retryablehttp"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -36,16 +39,30 @@ import (
  * Notes:
  *
  * The integration test will automatically skip tests if the required config file is not available.
+ *
+ * To record the interactions with the service, set MQCLOUD_CASSETTE to a cassette file and
+ * MQCLOUD_CASSETTE_MODE to "record". Tokens, API keys and GUIDs are scrubbed from the recording.
+ * With only MQCLOUD_CASSETTE set, the tests replay the cassette and need no configuration file or
+ * credentials. The certificate files named in the configuration must be present for replays.
+ * Every attempt of a retried request is recorded, including 429 and 5xx responses, so replays use the
+ * same retry settings as recordings, but retry at once instead of waiting.
  */
 
 var _ = Describe(`MqcloudV1 Integration Tests`, func() {
 	const externalConfigFile = "../mqcloud_v1.env"
 
+	// The retry settings used both to record and to replay.
+	const (
+		maxRetries       = 4
+		maxRetryInterval = 30 * time.Second
+	)
+
 	var (
 		err            error
 		mqcloudService *mqcloudv1.MqcloudV1
 		serviceURL     string
 		config         map[string]string
+		recorder       *mqcloudtest.Recorder
 	)
 
 	var shouldSkipTest = func() {
@@ -54,6 +71,20 @@ var _ = Describe(`MqcloudV1 Integration Tests`, func() {
 
 	Describe(`External configuration`, func() {
 		It("Successfully load the configuration", func() {
+			cassette := os.Getenv("MQCLOUD_CASSETTE")
+			if cassette != "" && os.Getenv("MQCLOUD_CASSETTE_MODE") != mqcloudtest.ModeRecord {
+				recorder, err = mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{
+					Mode: mqcloudtest.ModeReplay,
+					Path: cassette,
+				})
+				Expect(err).To(BeNil())
+				config = recorder.Config()
+				serviceURL = config["URL"]
+				fmt.Fprintf(GinkgoWriter, "Replaying cassette: %v\n", cassette)
+				shouldSkipTest = func() {}
+				return
+			}
+
 			_, err = os.Stat(externalConfigFile)
 			if err != nil {
 				Skip("External configuration file not found, skipping tests: " + err.Error())
@@ -69,6 +100,16 @@ var _ = Describe(`MqcloudV1 Integration Tests`, func() {
 				Skip("Unable to load service URL configuration property, skipping tests")
 			}
 
+			if cassette != "" {
+				recorder, err = mqcloudtest.NewRecorder(&mqcloudtest.RecorderOptions{
+					Mode:    mqcloudtest.ModeRecord,
+					Path:    cassette,
+					Secrets: []string{config["APIKEY"]},
+				})
+				Expect(err).To(BeNil())
+				recorder.SetConfig(config)
+			}
+
 			fmt.Fprintf(GinkgoWriter, "Service URL: %v\n", serviceURL)
 			shouldSkipTest = func() {}
 		})
@@ -79,6 +120,21 @@ var _ = Describe(`MqcloudV1 Integration Tests`, func() {
 			shouldSkipTest()
 		})
 		It("Successfully construct the service client instance", func() {
+			if recorder != nil && recorder.Mode() == mqcloudtest.ModeReplay {
+				mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+					URL:           serviceURL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(err).To(BeNil())
+				mqcloudService.EnableRetries(maxRetries, maxRetryInterval)
+				transport := mqcloudService.Service.Client.Transport.(*retryablehttp.RoundTripper)
+				transport.Client.Backoff = func(time.Duration, time.Duration, int, *http.Response) time.Duration {
+					return 0
+				}
+				recorder.Install(mqcloudService.Service)
+				return
+			}
+
 			mqcloudServiceOptions := &mqcloudv1.MqcloudV1Options{}
 
 			mqcloudService, err = mqcloudv1.NewMqcloudV1UsingExternalConfig(mqcloudServiceOptions)
@@ -87,7 +143,10 @@ var _ = Describe(`MqcloudV1 Integration Tests`, func() {
 			Expect(mqcloudService.Service.Options.URL).To(Equal(serviceURL))
 
 			core.SetLogger(core.NewLogger(core.LevelDebug, log.New(GinkgoWriter, "", log.LstdFlags), log.New(GinkgoWriter, "", log.LstdFlags)))
-			mqcloudService.EnableRetries(4, 30*time.Second)
+			mqcloudService.EnableRetries(maxRetries, maxRetryInterval)
+			if recorder != nil {
+				recorder.Install(mqcloudService.Service)
+			}
 		})
 	})
 
@@ -807,6 +866,22 @@ var _ = Describe(`MqcloudV1 Integration Tests`, func() {
 			Expect(queueManagerTaskStatus).ToNot(BeNil())
 		})
 	})
+
+	Describe(`Cassette`, func() {
+		BeforeEach(func() {
+			shouldSkipTest()
+			if recorder == nil {
+				Skip("No cassette, skipping")
+			}
+		})
+		It("Saves or fully replays the cassette", func() {
+			if recorder.Mode() == mqcloudtest.ModeRecord {
+				Expect(recorder.Save()).To(Succeed())
+			} else {
+				Expect(recorder.Remaining()).To(Equal(0))
+			}
+		})
+	})
 })
 
 //
@@ -0,0 +1,182 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// operationState tracks one call of an operation through the HTTP client, including its retries.
+// It travels in the request context so that the retry policy, which the client shares with its
+// clones, can tell which operation a request belongs to.
+type operationState struct {
+	// The operation ID passed to common.GetSdkHeaders, such as "CreateQueueManager".
+	id string
+
+	// The HTTP method of the request.
+	method string
+
+	// The retry policy for the operation.
+	retryPolicy RetryPolicy
+
+	// The number of retries made so far. Zero during the first attempt.
+	retries atomic.Int32
+
+	// Whether the current attempt wrote the request to a connection.
+	sent atomic.Bool
+
+	// The span of the operation, or nil if the client has no tracer.
+	span Span
+
+	// The rate limiter that each attempt waits for, or nil if the client has none.
+	rateLimiter *RateLimiter
+}
+
+type operationStateKey struct{}
+
+// operationStateFrom returns the operation state in ctx, or nil.
+func operationStateFrom(ctx context.Context) *operationState {
+	state, _ := ctx.Value(operationStateKey{}).(*operationState)
+	return state
+}
+
+// request sends the request for an operation and decodes the response into result. The options are
+// those the operation was called with.
+func (mqcloud *MqcloudV1) request(operationID string, options interface{}, req *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	state := &operationState{
+		id:          operationID,
+		method:      req.Method,
+		retryPolicy: mqcloud.GetRetryPolicy(operationID),
+	}
+	if mqcloud.metrics != nil {
+		metrics, start := mqcloud.metrics, time.Now()
+		defer func() {
+			observation := OperationObservation{
+				OperationID: state.id,
+				Duration:    time.Since(start),
+				Retries:     int(state.retries.Load()),
+				Err:         err,
+			}
+			if response != nil {
+				observation.StatusCode = response.StatusCode
+			}
+			metrics.ObserveOperation(observation)
+		}()
+	}
+	if mqcloud.tracer != nil {
+		req = mqcloud.startOperation(state, options, req)
+		defer func() {
+			end := SpanEnd{Retries: int(state.retries.Load()), Err: err}
+			if response != nil {
+				end.StatusCode = response.StatusCode
+			}
+			state.span.End(end)
+		}()
+	}
+	if len(mqcloud.interceptors) > 0 {
+		return mqcloud.intercept(state, options, req, result)
+	}
+	return mqcloud.invoke(state, options, req, result)
+}
+
+// invoke carries out an operation once its interceptors have run.
+func (mqcloud *MqcloudV1) invoke(state *operationState, options interface{}, req *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	if mqcloud.coordinator != nil && req.Method != http.MethodGet && req.Method != http.MethodHead {
+		if ref := queueManagerOf(options); ref.QueueManagerID != "" {
+			release, err := mqcloud.coordinator.acquire(req.Context(), ref)
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+		}
+	}
+	if mqcloud.transitionRetry != nil && transitionRetryOperations[state.id] {
+		return mqcloud.requestWithTransitionRetry(state, options, req, result)
+	}
+	return mqcloud.send(state, req, result)
+}
+
+// send makes one call of an operation, which the HTTP client may retry.
+func (mqcloud *MqcloudV1) send(state *operationState, req *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	// The first attempt waits for the rate limiter here, so that a request that is not allowed in
+	// time fails with the limiter's error; startAttempt waits for it before each retry.
+	state.rateLimiter = mqcloud.rateLimiter
+	if state.rateLimiter != nil {
+		if _, err := state.rateLimiter.Wait(req.Context(), state.id); err != nil {
+			return nil, err
+		}
+	}
+	mqcloud.ensureRetryPolicy()
+	ctx := context.WithValue(req.Context(), operationStateKey{}, state)
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		WroteHeaders: func() {
+			state.sent.Store(true)
+		},
+	})
+	return mqcloud.Service.Request(req.WithContext(ctx), result)
+}
+
+// queueManagerOf returns the queue manager an operation's options are for. The ref is empty for
+// operations that are not about a queue manager.
+func queueManagerOf(options interface{}) QueueManagerRef {
+	var guid, id *string
+	switch options := options.(type) {
+	case *CreateKeyStorePemCertificateOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *CreateTrustStorePemCertificateOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *DeleteKeyStoreCertificateOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *DeleteQueueManagerOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *DeleteTrustStoreCertificateOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *DownloadKeyStoreCertificateOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *DownloadTrustStoreCertificateOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *GetCertificateAmsChannelsOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *GetKeyStoreCertificateOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *GetQueueManagerAvailableUpgradeVersionsOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *GetQueueManagerConnectionInfoOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *GetQueueManagerOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *GetQueueManagerStatusOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *GetTrustStoreCertificateOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *ListKeyStoreCertificatesOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *ListTrustStoreCertificatesOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *SetCertificateAmsChannelsOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	case *SetQueueManagerVersionOptions:
+		guid, id = options.ServiceInstanceGuid, options.QueueManagerID
+	}
+	return QueueManagerRef{ServiceInstanceGuid: core.StringNilMapper(guid), QueueManagerID: core.StringNilMapper(id)}
+}
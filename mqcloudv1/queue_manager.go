@@ -0,0 +1,673 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// QueueManager : A handle for one queue manager in a service instance.
+// Its methods take the same options types as MqcloudV1 and fill in ServiceInstanceGuid and
+// QueueManagerID, so options for queue manager operations can be nil. Like ServiceInstance, a
+// QueueManager is immutable and rejects options that name a different queue manager.
+type QueueManager struct {
+	instance *ServiceInstance
+	id       string
+}
+
+// KeyStoreCertificate : A handle for one certificate in a queue manager's key store.
+type KeyStoreCertificate struct {
+	queueManager *QueueManager
+	id           string
+}
+
+// TrustStoreCertificate : A handle for one certificate in a queue manager's trust store.
+type TrustStoreCertificate struct {
+	queueManager *QueueManager
+	id           string
+}
+
+// QueueManager returns a handle for the queue manager with the given ID.
+func (instance *ServiceInstance) QueueManager(queueManagerID string) *QueueManager {
+	return &QueueManager{
+		instance: instance,
+		id:       queueManagerID,
+	}
+}
+
+// ID returns the ID of the queue manager.
+func (queueManager *QueueManager) ID() string {
+	return queueManager.id
+}
+
+// Instance returns the service instance the queue manager belongs to.
+func (queueManager *QueueManager) Instance() *ServiceInstance {
+	return queueManager.instance
+}
+
+// Ref returns a reference to the queue manager for use with TrustEachOther and similar methods.
+func (queueManager *QueueManager) Ref() QueueManagerRef {
+	return QueueManagerRef{
+		ServiceInstanceGuid: queueManager.instance.guid,
+		QueueManagerID:      queueManager.id,
+	}
+}
+
+// Certificate returns a handle for the key store certificate with the given ID. Key store
+// certificates are the ones that can be assigned AMS channels.
+func (queueManager *QueueManager) Certificate(certificateID string) *KeyStoreCertificate {
+	return queueManager.KeyStoreCertificate(certificateID)
+}
+
+// KeyStoreCertificate returns a handle for the key store certificate with the given ID.
+func (queueManager *QueueManager) KeyStoreCertificate(certificateID string) *KeyStoreCertificate {
+	return &KeyStoreCertificate{
+		queueManager: queueManager,
+		id:           certificateID,
+	}
+}
+
+// TrustStoreCertificate returns a handle for the trust store certificate with the given ID.
+func (queueManager *QueueManager) TrustStoreCertificate(certificateID string) *TrustStoreCertificate {
+	return &TrustStoreCertificate{
+		queueManager: queueManager,
+		id:           certificateID,
+	}
+}
+
+// ID returns the ID of the certificate.
+func (certificate *KeyStoreCertificate) ID() string {
+	return certificate.id
+}
+
+// QueueManager returns the queue manager the certificate belongs to.
+func (certificate *KeyStoreCertificate) QueueManager() *QueueManager {
+	return certificate.queueManager
+}
+
+// ID returns the ID of the certificate.
+func (certificate *TrustStoreCertificate) ID() string {
+	return certificate.id
+}
+
+// QueueManager returns the queue manager the certificate belongs to.
+func (certificate *TrustStoreCertificate) QueueManager() *QueueManager {
+	return certificate.queueManager
+}
+
+// bind returns the queue manager ID to use in place of the ID already set in an options struct.
+func (queueManager *QueueManager) bind(queueManagerID *string) (*string, error) {
+	return bindIdentifier("queue manager", queueManager.id, queueManagerID)
+}
+
+// bind returns the certificate ID to use in place of the ID already set in an options struct.
+func (certificate *KeyStoreCertificate) bind(certificateID *string) (*string, error) {
+	return bindIdentifier("certificate", certificate.id, certificateID)
+}
+
+// bind returns the certificate ID to use in place of the ID already set in an options struct.
+func (certificate *TrustStoreCertificate) bind(certificateID *string) (*string, error) {
+	return bindIdentifier("certificate", certificate.id, certificateID)
+}
+
+// ref returns a reference to the queue manager after checking that the handle is complete.
+func (queueManager *QueueManager) ref() (qm QueueManagerRef, err error) {
+	if _, err = queueManager.instance.bind(nil); err != nil {
+		return
+	}
+	if _, err = queueManager.bind(nil); err != nil {
+		return
+	}
+	return queueManager.Ref(), nil
+}
+
+// CheckKeyStoreHostnames : Check key store certificates against the queue manager's hostnames
+// See MqcloudV1.CheckKeyStoreHostnames.
+func (queueManager *QueueManager) CheckKeyStoreHostnames() (report *HostnameReport, err error) {
+	report, err = queueManager.CheckKeyStoreHostnamesWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CheckKeyStoreHostnamesWithContext is an alternate form of the CheckKeyStoreHostnames method which supports a Context parameter
+func (queueManager *QueueManager) CheckKeyStoreHostnamesWithContext(ctx context.Context) (report *HostnameReport, err error) {
+	qm, err := queueManager.ref()
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.CheckKeyStoreHostnamesWithContext(ctx, qm)
+}
+
+// PlanAmsChannels : Plan AMS channel assignments for the queue manager's key store certificates
+// See MqcloudV1.PlanAmsChannels.
+func (queueManager *QueueManager) PlanAmsChannels(desired map[string][]string) (plan *AmsChannelPlan, err error) {
+	plan, err = queueManager.PlanAmsChannelsWithContext(context.Background(), desired)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanAmsChannelsWithContext is an alternate form of the PlanAmsChannels method which supports a Context parameter
+func (queueManager *QueueManager) PlanAmsChannelsWithContext(ctx context.Context, desired map[string][]string) (plan *AmsChannelPlan, err error) {
+	qm, err := queueManager.ref()
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.PlanAmsChannelsWithContext(ctx, qm, desired)
+}
+
+// Get : Get details of a queue manager
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) Get(getQueueManagerOptions *GetQueueManagerOptions) (result *QueueManagerDetails, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.GetWithContext(context.Background(), getQueueManagerOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetWithContext is an alternate form of the Get method which supports a Context parameter
+func (queueManager *QueueManager) GetWithContext(ctx context.Context, getQueueManagerOptions *GetQueueManagerOptions) (result *QueueManagerDetails, response *core.DetailedResponse, err error) {
+	bound := &GetQueueManagerOptions{}
+	if getQueueManagerOptions != nil {
+		optionsCopy := *getQueueManagerOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.GetQueueManagerWithContext(ctx, bound)
+}
+
+// Delete : Delete a queue manager
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) Delete(deleteQueueManagerOptions *DeleteQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.DeleteWithContext(context.Background(), deleteQueueManagerOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteWithContext is an alternate form of the Delete method which supports a Context parameter
+func (queueManager *QueueManager) DeleteWithContext(ctx context.Context, deleteQueueManagerOptions *DeleteQueueManagerOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	bound := &DeleteQueueManagerOptions{}
+	if deleteQueueManagerOptions != nil {
+		optionsCopy := *deleteQueueManagerOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.DeleteQueueManagerWithContext(ctx, bound)
+}
+
+// GetStatus : Get the status of the queue manager
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) GetStatus(getQueueManagerStatusOptions *GetQueueManagerStatusOptions) (result *QueueManagerStatus, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.GetStatusWithContext(context.Background(), getQueueManagerStatusOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetStatusWithContext is an alternate form of the GetStatus method which supports a Context parameter
+func (queueManager *QueueManager) GetStatusWithContext(ctx context.Context, getQueueManagerStatusOptions *GetQueueManagerStatusOptions) (result *QueueManagerStatus, response *core.DetailedResponse, err error) {
+	bound := &GetQueueManagerStatusOptions{}
+	if getQueueManagerStatusOptions != nil {
+		optionsCopy := *getQueueManagerStatusOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.GetQueueManagerStatusWithContext(ctx, bound)
+}
+
+// GetConnectionInfo : Get connection information for a queue manager
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) GetConnectionInfo(getQueueManagerConnectionInfoOptions *GetQueueManagerConnectionInfoOptions) (result *ConnectionInfo, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.GetConnectionInfoWithContext(context.Background(), getQueueManagerConnectionInfoOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetConnectionInfoWithContext is an alternate form of the GetConnectionInfo method which supports a Context parameter
+func (queueManager *QueueManager) GetConnectionInfoWithContext(ctx context.Context, getQueueManagerConnectionInfoOptions *GetQueueManagerConnectionInfoOptions) (result *ConnectionInfo, response *core.DetailedResponse, err error) {
+	bound := &GetQueueManagerConnectionInfoOptions{}
+	if getQueueManagerConnectionInfoOptions != nil {
+		optionsCopy := *getQueueManagerConnectionInfoOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.GetQueueManagerConnectionInfoWithContext(ctx, bound)
+}
+
+// GetAvailableUpgradeVersions : Get the list of available versions that this queue manager can be upgraded to
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) GetAvailableUpgradeVersions(getQueueManagerAvailableUpgradeVersionsOptions *GetQueueManagerAvailableUpgradeVersionsOptions) (result *QueueManagerVersionUpgrades, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.GetAvailableUpgradeVersionsWithContext(context.Background(), getQueueManagerAvailableUpgradeVersionsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAvailableUpgradeVersionsWithContext is an alternate form of the GetAvailableUpgradeVersions method which supports a Context parameter
+func (queueManager *QueueManager) GetAvailableUpgradeVersionsWithContext(ctx context.Context, getQueueManagerAvailableUpgradeVersionsOptions *GetQueueManagerAvailableUpgradeVersionsOptions) (result *QueueManagerVersionUpgrades, response *core.DetailedResponse, err error) {
+	bound := &GetQueueManagerAvailableUpgradeVersionsOptions{}
+	if getQueueManagerAvailableUpgradeVersionsOptions != nil {
+		optionsCopy := *getQueueManagerAvailableUpgradeVersionsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.GetQueueManagerAvailableUpgradeVersionsWithContext(ctx, bound)
+}
+
+// SetVersion : Upgrade a queue manager
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) SetVersion(setQueueManagerVersionOptions *SetQueueManagerVersionOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.SetVersionWithContext(context.Background(), setQueueManagerVersionOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetVersionWithContext is an alternate form of the SetVersion method which supports a Context parameter
+func (queueManager *QueueManager) SetVersionWithContext(ctx context.Context, setQueueManagerVersionOptions *SetQueueManagerVersionOptions) (result *QueueManagerTaskStatus, response *core.DetailedResponse, err error) {
+	bound := &SetQueueManagerVersionOptions{}
+	if setQueueManagerVersionOptions != nil {
+		optionsCopy := *setQueueManagerVersionOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.SetQueueManagerVersionWithContext(ctx, bound)
+}
+
+// ListTrustStoreCertificates : List trust store certificates
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) ListTrustStoreCertificates(listTrustStoreCertificatesOptions *ListTrustStoreCertificatesOptions) (result *TrustStoreCertificateDetailsCollection, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.ListTrustStoreCertificatesWithContext(context.Background(), listTrustStoreCertificatesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListTrustStoreCertificatesWithContext is an alternate form of the ListTrustStoreCertificates method which supports a Context parameter
+func (queueManager *QueueManager) ListTrustStoreCertificatesWithContext(ctx context.Context, listTrustStoreCertificatesOptions *ListTrustStoreCertificatesOptions) (result *TrustStoreCertificateDetailsCollection, response *core.DetailedResponse, err error) {
+	bound := &ListTrustStoreCertificatesOptions{}
+	if listTrustStoreCertificatesOptions != nil {
+		optionsCopy := *listTrustStoreCertificatesOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.ListTrustStoreCertificatesWithContext(ctx, bound)
+}
+
+// CreateTrustStorePemCertificate : Upload a trust store certificate
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) CreateTrustStorePemCertificate(createTrustStorePemCertificateOptions *CreateTrustStorePemCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.CreateTrustStorePemCertificateWithContext(context.Background(), createTrustStorePemCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateTrustStorePemCertificateWithContext is an alternate form of the CreateTrustStorePemCertificate method which supports a Context parameter
+func (queueManager *QueueManager) CreateTrustStorePemCertificateWithContext(ctx context.Context, createTrustStorePemCertificateOptions *CreateTrustStorePemCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	bound := &CreateTrustStorePemCertificateOptions{}
+	if createTrustStorePemCertificateOptions != nil {
+		optionsCopy := *createTrustStorePemCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.CreateTrustStorePemCertificateWithContext(ctx, bound)
+}
+
+// ListKeyStoreCertificates : List key store certificates
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) ListKeyStoreCertificates(listKeyStoreCertificatesOptions *ListKeyStoreCertificatesOptions) (result *KeyStoreCertificateDetailsCollection, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.ListKeyStoreCertificatesWithContext(context.Background(), listKeyStoreCertificatesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListKeyStoreCertificatesWithContext is an alternate form of the ListKeyStoreCertificates method which supports a Context parameter
+func (queueManager *QueueManager) ListKeyStoreCertificatesWithContext(ctx context.Context, listKeyStoreCertificatesOptions *ListKeyStoreCertificatesOptions) (result *KeyStoreCertificateDetailsCollection, response *core.DetailedResponse, err error) {
+	bound := &ListKeyStoreCertificatesOptions{}
+	if listKeyStoreCertificatesOptions != nil {
+		optionsCopy := *listKeyStoreCertificatesOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.ListKeyStoreCertificatesWithContext(ctx, bound)
+}
+
+// CreateKeyStorePemCertificate : Upload a key store certificate
+// The options are copied and their ServiceInstanceGuid and QueueManagerID set from the handle.
+func (queueManager *QueueManager) CreateKeyStorePemCertificate(createKeyStorePemCertificateOptions *CreateKeyStorePemCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	result, response, err = queueManager.CreateKeyStorePemCertificateWithContext(context.Background(), createKeyStorePemCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateKeyStorePemCertificateWithContext is an alternate form of the CreateKeyStorePemCertificate method which supports a Context parameter
+func (queueManager *QueueManager) CreateKeyStorePemCertificateWithContext(ctx context.Context, createKeyStorePemCertificateOptions *CreateKeyStorePemCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	bound := &CreateKeyStorePemCertificateOptions{}
+	if createKeyStorePemCertificateOptions != nil {
+		optionsCopy := *createKeyStorePemCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	return queueManager.instance.client.CreateKeyStorePemCertificateWithContext(ctx, bound)
+}
+
+// Get : Get a key store certificate for queue manager
+// The options are copied and their ServiceInstanceGuid, QueueManagerID and CertificateID set from the handle.
+func (certificate *KeyStoreCertificate) Get(getKeyStoreCertificateOptions *GetKeyStoreCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	result, response, err = certificate.GetWithContext(context.Background(), getKeyStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetWithContext is an alternate form of the Get method which supports a Context parameter
+func (certificate *KeyStoreCertificate) GetWithContext(ctx context.Context, getKeyStoreCertificateOptions *GetKeyStoreCertificateOptions) (result *KeyStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	bound := &GetKeyStoreCertificateOptions{}
+	if getKeyStoreCertificateOptions != nil {
+		optionsCopy := *getKeyStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = certificate.queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = certificate.queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	bound.CertificateID, err = certificate.bind(bound.CertificateID)
+	if err != nil {
+		return
+	}
+	return certificate.queueManager.instance.client.GetKeyStoreCertificateWithContext(ctx, bound)
+}
+
+// Delete : Delete a queue manager's key store certificate
+// The options are copied and their ServiceInstanceGuid, QueueManagerID and CertificateID set from the handle.
+func (certificate *KeyStoreCertificate) Delete(deleteKeyStoreCertificateOptions *DeleteKeyStoreCertificateOptions) (response *core.DetailedResponse, err error) {
+	response, err = certificate.DeleteWithContext(context.Background(), deleteKeyStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteWithContext is an alternate form of the Delete method which supports a Context parameter
+func (certificate *KeyStoreCertificate) DeleteWithContext(ctx context.Context, deleteKeyStoreCertificateOptions *DeleteKeyStoreCertificateOptions) (response *core.DetailedResponse, err error) {
+	bound := &DeleteKeyStoreCertificateOptions{}
+	if deleteKeyStoreCertificateOptions != nil {
+		optionsCopy := *deleteKeyStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = certificate.queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = certificate.queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	bound.CertificateID, err = certificate.bind(bound.CertificateID)
+	if err != nil {
+		return
+	}
+	return certificate.queueManager.instance.client.DeleteKeyStoreCertificateWithContext(ctx, bound)
+}
+
+// Download : Download a queue manager's certificate from its key store
+// The options are copied and their ServiceInstanceGuid, QueueManagerID and CertificateID set from the handle.
+func (certificate *KeyStoreCertificate) Download(downloadKeyStoreCertificateOptions *DownloadKeyStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	result, response, err = certificate.DownloadWithContext(context.Background(), downloadKeyStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DownloadWithContext is an alternate form of the Download method which supports a Context parameter
+func (certificate *KeyStoreCertificate) DownloadWithContext(ctx context.Context, downloadKeyStoreCertificateOptions *DownloadKeyStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	bound := &DownloadKeyStoreCertificateOptions{}
+	if downloadKeyStoreCertificateOptions != nil {
+		optionsCopy := *downloadKeyStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = certificate.queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = certificate.queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	bound.CertificateID, err = certificate.bind(bound.CertificateID)
+	if err != nil {
+		return
+	}
+	return certificate.queueManager.instance.client.DownloadKeyStoreCertificateWithContext(ctx, bound)
+}
+
+// GetAmsChannels : Get the AMS channels that are configured with this key store certificate
+// The options are copied and their ServiceInstanceGuid, QueueManagerID and CertificateID set from the handle.
+func (certificate *KeyStoreCertificate) GetAmsChannels(getCertificateAmsChannelsOptions *GetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error) {
+	result, response, err = certificate.GetAmsChannelsWithContext(context.Background(), getCertificateAmsChannelsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAmsChannelsWithContext is an alternate form of the GetAmsChannels method which supports a Context parameter
+func (certificate *KeyStoreCertificate) GetAmsChannelsWithContext(ctx context.Context, getCertificateAmsChannelsOptions *GetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error) {
+	bound := &GetCertificateAmsChannelsOptions{}
+	if getCertificateAmsChannelsOptions != nil {
+		optionsCopy := *getCertificateAmsChannelsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = certificate.queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = certificate.queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	bound.CertificateID, err = certificate.bind(bound.CertificateID)
+	if err != nil {
+		return
+	}
+	return certificate.queueManager.instance.client.GetCertificateAmsChannelsWithContext(ctx, bound)
+}
+
+// SetAmsChannels : Update the AMS channels that are configured with this key store certificate
+// The options are copied and their ServiceInstanceGuid, QueueManagerID and CertificateID set from the handle.
+func (certificate *KeyStoreCertificate) SetAmsChannels(setCertificateAmsChannelsOptions *SetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error) {
+	result, response, err = certificate.SetAmsChannelsWithContext(context.Background(), setCertificateAmsChannelsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SetAmsChannelsWithContext is an alternate form of the SetAmsChannels method which supports a Context parameter
+func (certificate *KeyStoreCertificate) SetAmsChannelsWithContext(ctx context.Context, setCertificateAmsChannelsOptions *SetCertificateAmsChannelsOptions) (result *ChannelsDetails, response *core.DetailedResponse, err error) {
+	bound := &SetCertificateAmsChannelsOptions{}
+	if setCertificateAmsChannelsOptions != nil {
+		optionsCopy := *setCertificateAmsChannelsOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = certificate.queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = certificate.queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	bound.CertificateID, err = certificate.bind(bound.CertificateID)
+	if err != nil {
+		return
+	}
+	return certificate.queueManager.instance.client.SetCertificateAmsChannelsWithContext(ctx, bound)
+}
+
+// Get : Get a trust store certificate
+// The options are copied and their ServiceInstanceGuid, QueueManagerID and CertificateID set from the handle.
+func (certificate *TrustStoreCertificate) Get(getTrustStoreCertificateOptions *GetTrustStoreCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	result, response, err = certificate.GetWithContext(context.Background(), getTrustStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetWithContext is an alternate form of the Get method which supports a Context parameter
+func (certificate *TrustStoreCertificate) GetWithContext(ctx context.Context, getTrustStoreCertificateOptions *GetTrustStoreCertificateOptions) (result *TrustStoreCertificateDetails, response *core.DetailedResponse, err error) {
+	bound := &GetTrustStoreCertificateOptions{}
+	if getTrustStoreCertificateOptions != nil {
+		optionsCopy := *getTrustStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = certificate.queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = certificate.queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	bound.CertificateID, err = certificate.bind(bound.CertificateID)
+	if err != nil {
+		return
+	}
+	return certificate.queueManager.instance.client.GetTrustStoreCertificateWithContext(ctx, bound)
+}
+
+// Delete : Delete a trust store certificate
+// The options are copied and their ServiceInstanceGuid, QueueManagerID and CertificateID set from the handle.
+func (certificate *TrustStoreCertificate) Delete(deleteTrustStoreCertificateOptions *DeleteTrustStoreCertificateOptions) (response *core.DetailedResponse, err error) {
+	response, err = certificate.DeleteWithContext(context.Background(), deleteTrustStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteWithContext is an alternate form of the Delete method which supports a Context parameter
+func (certificate *TrustStoreCertificate) DeleteWithContext(ctx context.Context, deleteTrustStoreCertificateOptions *DeleteTrustStoreCertificateOptions) (response *core.DetailedResponse, err error) {
+	bound := &DeleteTrustStoreCertificateOptions{}
+	if deleteTrustStoreCertificateOptions != nil {
+		optionsCopy := *deleteTrustStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = certificate.queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = certificate.queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	bound.CertificateID, err = certificate.bind(bound.CertificateID)
+	if err != nil {
+		return
+	}
+	return certificate.queueManager.instance.client.DeleteTrustStoreCertificateWithContext(ctx, bound)
+}
+
+// Download : Download a queue manager's certificate from its trust store
+// The options are copied and their ServiceInstanceGuid, QueueManagerID and CertificateID set from the handle.
+func (certificate *TrustStoreCertificate) Download(downloadTrustStoreCertificateOptions *DownloadTrustStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	result, response, err = certificate.DownloadWithContext(context.Background(), downloadTrustStoreCertificateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DownloadWithContext is an alternate form of the Download method which supports a Context parameter
+func (certificate *TrustStoreCertificate) DownloadWithContext(ctx context.Context, downloadTrustStoreCertificateOptions *DownloadTrustStoreCertificateOptions) (result io.ReadCloser, response *core.DetailedResponse, err error) {
+	bound := &DownloadTrustStoreCertificateOptions{}
+	if downloadTrustStoreCertificateOptions != nil {
+		optionsCopy := *downloadTrustStoreCertificateOptions
+		bound = &optionsCopy
+	}
+	bound.ServiceInstanceGuid, err = certificate.queueManager.instance.bind(bound.ServiceInstanceGuid)
+	if err != nil {
+		return
+	}
+	bound.QueueManagerID, err = certificate.queueManager.bind(bound.QueueManagerID)
+	if err != nil {
+		return
+	}
+	bound.CertificateID, err = certificate.bind(bound.CertificateID)
+	if err != nil {
+		return
+	}
+	return certificate.queueManager.instance.client.DownloadTrustStoreCertificateWithContext(ctx, bound)
+}
@@ -0,0 +1,122 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`QueueManager`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		server       *httptest.Server
+		mutex        sync.Mutex
+		requests     []string
+		queueManager *mqcloudv1.QueueManager
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			mutex.Unlock()
+			switch req.Method {
+			case "DELETE":
+				res.WriteHeader(204)
+			default:
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				_, _ = io.WriteString(res, `{"status": "running", "channels": [{"name": "APP.SVRCONN"}]}`)
+			}
+		}))
+
+		mqcloudService, err := mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		queueManager = mqcloudService.Instance(serviceInstanceGuid).QueueManager("qm1")
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Fills in the service instance GUID and queue manager ID`, func() {
+		Expect(queueManager.ID()).To(Equal("qm1"))
+		Expect(queueManager.Instance().GUID()).To(Equal(serviceInstanceGuid))
+		Expect(queueManager.Ref()).To(Equal(mqcloudv1.QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuid, QueueManagerID: "qm1"}))
+
+		status, _, err := queueManager.GetStatus(nil)
+		Expect(err).To(BeNil())
+		Expect(*status.Status).To(Equal("running"))
+
+		options := &mqcloudv1.SetQueueManagerVersionOptions{Version: core.StringPtr("9.3.5_1")}
+		_, _, err = queueManager.SetVersion(options)
+		Expect(err).To(BeNil())
+		Expect(options.QueueManagerID).To(BeNil())
+
+		Expect(requests).To(Equal([]string{
+			"GET /v1/" + serviceInstanceGuid + "/queue_managers/qm1/status",
+			"PUT /v1/" + serviceInstanceGuid + "/queue_managers/qm1/version",
+		}))
+	})
+	It(`Fills in the certificate ID on certificate handles`, func() {
+		certificate := queueManager.Certificate("ks1")
+		Expect(certificate.ID()).To(Equal("ks1"))
+		Expect(certificate.QueueManager()).To(BeIdenticalTo(queueManager))
+
+		channels, _, err := certificate.GetAmsChannels(nil)
+		Expect(err).To(BeNil())
+		Expect(*channels.Channels[0].Name).To(Equal("APP.SVRCONN"))
+		_, _, err = certificate.SetAmsChannels(&mqcloudv1.SetCertificateAmsChannelsOptions{
+			Channels: []mqcloudv1.ChannelDetails{{Name: core.StringPtr("APP.SVRCONN")}},
+		})
+		Expect(err).To(BeNil())
+		_, err = queueManager.TrustStoreCertificate("ts1").Delete(nil)
+		Expect(err).To(BeNil())
+
+		prefix := "/v1/" + serviceInstanceGuid + "/queue_managers/qm1/certificates/"
+		Expect(requests).To(Equal([]string{
+			"GET " + prefix + "key_store/ks1/config/ams",
+			"PUT " + prefix + "key_store/ks1/config/ams",
+			"DELETE " + prefix + "trust_store/ts1",
+		}))
+	})
+	It(`Rejects options for another queue manager or certificate`, func() {
+		_, _, err := queueManager.Get(&mqcloudv1.GetQueueManagerOptions{QueueManagerID: core.StringPtr("qm2")})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("qm2"))
+
+		_, _, err = queueManager.Certificate("ks1").Download(&mqcloudv1.DownloadKeyStoreCertificateOptions{CertificateID: core.StringPtr("ks2")})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("ks2"))
+
+		_, err = queueManager.Instance().QueueManager("").CheckKeyStoreHostnames()
+		Expect(err).ToNot(BeNil())
+		Expect(requests).To(BeEmpty())
+	})
+})
@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimit : A token bucket limit on requests.
+type RateLimit struct {
+	// The sustained number of requests per second.
+	Rate float64
+
+	// The number of requests that can be made at once after a quiet period. Defaults to 1.
+	Burst int
+}
+
+// RateLimiterOptions : Settings for NewRateLimiter.
+type RateLimiterOptions struct {
+	// The limit on all requests made through the limiter. Nil means no overall limit.
+	Global *RateLimit
+
+	// Limits for individual operations, by operation ID, such as "ListQueueManagers". They apply as
+	// well as the global limit.
+	Operations map[string]RateLimit
+
+	// Called after each wait for the limiter, with the time spent waiting. It is called even when
+	// there was no wait, so it can feed a histogram, and once for every attempt, so retries are
+	// included. It must be safe for concurrent use.
+	OnWait func(operationID string, wait time.Duration)
+}
+
+// RateLimiter : A client-side limit on the rate of requests, using token buckets.
+// Set it on a client with SetRateLimiter; clones of the client share it, so the limit applies to all
+// of them together. A RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	mutex      sync.Mutex
+	now        func() time.Time
+	global     *tokenBucket
+	operations map[string]*tokenBucket
+	onWait     func(operationID string, wait time.Duration)
+}
+
+// tokenBucket holds up to burst tokens, refilled at rate per second. Tokens go negative for
+// requests that have reserved a token and are waiting for it.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a rate limiter with the given limits.
+func NewRateLimiter(options *RateLimiterOptions) (limiter *RateLimiter, err error) {
+	if options == nil {
+		options = &RateLimiterOptions{}
+	}
+	limiter = &RateLimiter{
+		now:        time.Now,
+		operations: make(map[string]*tokenBucket),
+		onWait:     options.OnWait,
+	}
+	start := limiter.now()
+	if options.Global != nil {
+		if limiter.global, err = newTokenBucket(*options.Global, start); err != nil {
+			return nil, fmt.Errorf("global rate limit: %w", err)
+		}
+	}
+	for operationID, limit := range options.Operations {
+		if limiter.operations[operationID], err = newTokenBucket(limit, start); err != nil {
+			return nil, fmt.Errorf("rate limit for %s: %w", operationID, err)
+		}
+	}
+	return limiter, nil
+}
+
+func newTokenBucket(limit RateLimit, start time.Time) (*tokenBucket, error) {
+	if limit.Rate <= 0 {
+		return nil, fmt.Errorf("rate must be positive, not %v", limit.Rate)
+	}
+	if limit.Burst < 0 {
+		return nil, fmt.Errorf("burst must not be negative, not %d", limit.Burst)
+	}
+	burst := float64(limit.Burst)
+	if burst == 0 {
+		burst = 1
+	}
+	return &tokenBucket{rate: limit.Rate, burst: burst, tokens: burst, last: start}, nil
+}
+
+// reserve takes a token and returns how long until it is available.
+func (bucket *tokenBucket) reserve(now time.Time) time.Duration {
+	if elapsed := now.Sub(bucket.last); elapsed > 0 {
+		bucket.tokens += elapsed.Seconds() * bucket.rate
+		if bucket.tokens > bucket.burst {
+			bucket.tokens = bucket.burst
+		}
+		bucket.last = now
+	}
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+}
+
+// Wait blocks until a request for the operation is allowed, or ctx ends. It returns the time spent
+// waiting. A request whose wait would outlast the context's deadline fails at once.
+func (limiter *RateLimiter) Wait(ctx context.Context, operationID string) (wait time.Duration, err error) {
+	if err = ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	limiter.mutex.Lock()
+	now := limiter.now()
+	var buckets []*tokenBucket
+	if limiter.global != nil {
+		buckets = append(buckets, limiter.global)
+	}
+	if bucket, ok := limiter.operations[operationID]; ok {
+		buckets = append(buckets, bucket)
+	}
+	for _, bucket := range buckets {
+		if delay := bucket.reserve(now); delay > wait {
+			wait = delay
+		}
+	}
+	limiter.mutex.Unlock()
+
+	if wait > 0 {
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(wait)) {
+			err = fmt.Errorf("rate limit wait of %s for %s would pass the context deadline: %w", wait, operationID, context.DeadlineExceeded)
+		} else {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				err = ctx.Err()
+			case <-timer.C:
+			}
+		}
+		if err != nil {
+			// Give back the tokens so later requests are not held up by one that never ran.
+			limiter.mutex.Lock()
+			for _, bucket := range buckets {
+				bucket.tokens++
+			}
+			limiter.mutex.Unlock()
+			wait = limiter.now().Sub(now)
+		}
+	}
+
+	if limiter.onWait != nil {
+		limiter.onWait(operationID, wait)
+	}
+	return wait, err
+}
+
+// SetRateLimiter makes the client wait for the limiter before each request it sends, so every retry
+// of an operation takes a token as well as the first attempt. Nil removes the limit. Clones made
+// afterwards share the limiter.
+func (mqcloud *MqcloudV1) SetRateLimiter(limiter *RateLimiter) {
+	mqcloud.rateLimiter = limiter
+}
+
+// GetRateLimiter returns the rate limiter set with SetRateLimiter, or nil.
+func (mqcloud *MqcloudV1) GetRateLimiter() *RateLimiter {
+	return mqcloud.rateLimiter
+}
@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RateLimiter`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		waitsMutex     sync.Mutex
+		waits          map[string][]time.Duration
+	)
+
+	BeforeEach(func() {
+		emulator := mqcloudtest.NewServer(nil)
+		emulator.AddServiceInstance(serviceInstanceGuid)
+		testServer = httptest.NewServer(emulator)
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		waits = map[string][]time.Duration{}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	onWait := func(operationID string, wait time.Duration) {
+		waitsMutex.Lock()
+		defer waitsMutex.Unlock()
+		waits[operationID] = append(waits[operationID], wait)
+	}
+
+	It(`Limits requests across clones and reports waits`, func() {
+		limiter, err := mqcloudv1.NewRateLimiter(&mqcloudv1.RateLimiterOptions{
+			Global: &mqcloudv1.RateLimit{Rate: 20, Burst: 2},
+			OnWait: onWait,
+		})
+		Expect(err).To(BeNil())
+		mqcloudService.SetRateLimiter(limiter)
+		clone := mqcloudService.Clone()
+		Expect(clone.GetRateLimiter()).To(BeIdenticalTo(limiter))
+
+		started := time.Now()
+		for _, client := range []*mqcloudv1.MqcloudV1{mqcloudService, clone, mqcloudService, clone} {
+			_, _, err = client.GetOptions(client.NewGetOptionsOptions(serviceInstanceGuid))
+			Expect(err).To(BeNil())
+		}
+		// Two requests use the burst; the other two wait 50ms each.
+		Expect(time.Since(started)).To(BeNumerically(">=", 90*time.Millisecond))
+		Expect(waits["GetOptions"]).To(HaveLen(4))
+		Expect(waits["GetOptions"][0]).To(BeZero())
+		Expect(waits["GetOptions"][3]).To(BeNumerically(">", 0))
+	})
+	It(`Limits individual operations`, func() {
+		limiter, err := mqcloudv1.NewRateLimiter(&mqcloudv1.RateLimiterOptions{
+			Operations: map[string]mqcloudv1.RateLimit{"ListUsers": {Rate: 0.001}},
+			OnWait:     onWait,
+		})
+		Expect(err).To(BeNil())
+		mqcloudService.SetRateLimiter(limiter)
+
+		_, _, err = mqcloudService.ListUsers(mqcloudService.NewListUsersOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		for i := 0; i < 3; i++ {
+			_, _, err = mqcloudService.GetUsageDetails(mqcloudService.NewGetUsageDetailsOptions(serviceInstanceGuid))
+			Expect(err).To(BeNil())
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		started := time.Now()
+		_, _, err = mqcloudService.ListUsersWithContext(ctx, mqcloudService.NewListUsersOptions(serviceInstanceGuid))
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(time.Since(started)).To(BeNumerically("<", time.Second))
+		Expect(waits["GetUsageDetails"]).To(ConsistOf(BeZero(), BeZero(), BeZero()))
+	})
+	It(`Takes a token for each retry`, func() {
+		var attempts atomic.Int32
+		retryServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-Type", "application/json")
+			if attempts.Add(1) <= 2 {
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = io.WriteString(res, `{}`)
+		}))
+		defer retryServer.Close()
+		Expect(mqcloudService.SetServiceURL(retryServer.URL)).To(Succeed())
+		mqcloudService.EnableRetries(3, time.Second)
+		limiter, err := mqcloudv1.NewRateLimiter(&mqcloudv1.RateLimiterOptions{
+			Global: &mqcloudv1.RateLimit{Rate: 20},
+			OnWait: onWait,
+		})
+		Expect(err).To(BeNil())
+		mqcloudService.SetRateLimiter(limiter)
+
+		started := time.Now()
+		_, _, err = mqcloudService.GetOptions(mqcloudService.NewGetOptionsOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		Expect(attempts.Load()).To(Equal(int32(3)))
+		// The first attempt uses the burst; each retry waits 50ms for a token.
+		Expect(time.Since(started)).To(BeNumerically(">=", 90*time.Millisecond))
+		Expect(waits["GetOptions"]).To(HaveLen(3))
+	})
+	It(`Stops waiting when the context is cancelled`, func() {
+		limiter, err := mqcloudv1.NewRateLimiter(&mqcloudv1.RateLimiterOptions{Global: &mqcloudv1.RateLimit{Rate: 10}})
+		Expect(err).To(BeNil())
+		mqcloudService.SetRateLimiter(limiter)
+		_, _, err = mqcloudService.GetOptions(mqcloudService.NewGetOptionsOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+		_, _, err = mqcloudService.GetOptionsWithContext(ctx, mqcloudService.NewGetOptionsOptions(serviceInstanceGuid))
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+
+		// The cancelled request gave its token back, so this one waits less than a full interval.
+		wait, err := limiter.Wait(context.Background(), "GetOptions")
+		Expect(err).To(BeNil())
+		Expect(wait).To(BeNumerically("<", 100*time.Millisecond))
+	})
+	It(`Rejects invalid limits`, func() {
+		_, err := mqcloudv1.NewRateLimiter(&mqcloudv1.RateLimiterOptions{Global: &mqcloudv1.RateLimit{}})
+		Expect(err).ToNot(BeNil())
+		_, err = mqcloudv1.NewRateLimiter(&mqcloudv1.RateLimiterOptions{Operations: map[string]mqcloudv1.RateLimit{"GetUser": {Rate: 1, Burst: -1}}})
+		Expect(err).ToNot(BeNil())
+	})
+})
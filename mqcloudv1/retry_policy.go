@@ -0,0 +1,214 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Constants for RetryPolicy.Mode.
+const (
+	// Retry on connection errors, 429 and 5xx responses other than 501.
+	RetryPolicy_Mode_Always = "always"
+
+	// Retry only when the request was never written to a connection, so the server cannot have
+	// acted on it.
+	RetryPolicy_Mode_IfNotSent = "if_not_sent"
+
+	// Never retry.
+	RetryPolicy_Mode_Never = "never"
+)
+
+// RetryPolicy : When an operation is retried once retries are enabled with EnableRetries.
+// By default GET, PUT and DELETE operations use RetryPolicy_Mode_Always, and POST operations, such as
+// CreateQueueManager and CreateApplicationApikey, use RetryPolicy_Mode_IfNotSent so that a retry
+// cannot create a duplicate resource or mint an extra API key.
+type RetryPolicy struct {
+	// When to retry, one of the RetryPolicy_Mode_* constants.
+	Mode string
+
+	// The most retries for the operation. Zero uses the limit set with EnableRetries, which also
+	// caps this one.
+	MaxRetries int
+}
+
+// defaultRetryPolicy returns the retry policy for an operation with no policy set.
+func defaultRetryPolicy(method string) RetryPolicy {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return RetryPolicy{Mode: RetryPolicy_Mode_Always}
+	default:
+		return RetryPolicy{Mode: RetryPolicy_Mode_IfNotSent}
+	}
+}
+
+// SetRetryPolicy sets the retry policy for an operation, identified by its method name, such as
+// "CreateQueueManager". A nil policy restores the default. Policies are copied by Clone.
+func (mqcloud *MqcloudV1) SetRetryPolicy(operationID string, policy *RetryPolicy) {
+	policies := make(map[string]RetryPolicy, len(mqcloud.retryPolicies)+1)
+	for id, existing := range mqcloud.retryPolicies {
+		policies[id] = existing
+	}
+	if policy == nil {
+		delete(policies, operationID)
+	} else {
+		policies[operationID] = *policy
+	}
+	mqcloud.retryPolicies = policies
+}
+
+// GetRetryPolicy returns the retry policy set for an operation, or a zero policy if the default for
+// the operation's HTTP method applies.
+func (mqcloud *MqcloudV1) GetRetryPolicy(operationID string) RetryPolicy {
+	return mqcloud.retryPolicies[operationID]
+}
+
+// retryPolicyMutex serializes installing the retry policies, which clones sharing an HTTP client
+// may do at the same time.
+var retryPolicyMutex sync.Mutex
+
+// installRetryPolicy makes the retryable client use the per-operation retry policies.
+func (mqcloud *MqcloudV1) installRetryPolicy() {
+	retryPolicyMutex.Lock()
+	defer retryPolicyMutex.Unlock()
+	if transport, ok := mqcloud.Service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		transport.Client.CheckRetry = checkRetry
+		transport.Client.Backoff = retryBackoff
+		transport.Client.RequestLogHook = startAttempt
+	}
+}
+
+// ensureRetryPolicy installs the retry policies on a retryable client that the core set up without
+// them: when retries are enabled by external configuration, or by calling Service.EnableRetries
+// directly. Such a client is recognized by its lack of a RequestLogHook.
+func (mqcloud *MqcloudV1) ensureRetryPolicy() {
+	transport, ok := mqcloud.Service.Client.Transport.(*retryablehttp.RoundTripper)
+	if !ok {
+		return
+	}
+	retryPolicyMutex.Lock()
+	installed := transport.Client.RequestLogHook != nil
+	retryPolicyMutex.Unlock()
+	if !installed {
+		mqcloud.installRetryPolicy()
+	}
+}
+
+// startAttempt records the start of each attempt at an operation's request, and makes each retry
+// wait for the rate limiter like the first attempt did.
+func startAttempt(_ retryablehttp.Logger, req *http.Request, attempt int) {
+	if state := operationStateFrom(req.Context()); state != nil {
+		state.retries.Store(int32(attempt))
+		state.sent.Store(false)
+		if state.span != nil && attempt > 0 {
+			state.span.Retry(attempt)
+		}
+		if state.rateLimiter != nil && attempt > 0 {
+			ctx := req.Context()
+			if _, err := state.rateLimiter.Wait(ctx, state.id); err != nil {
+				// The hook cannot fail the attempt, so hold it until the context ends, which fails it
+				// without going over the limit.
+				<-ctx.Done()
+			}
+		}
+	}
+}
+
+// checkRetry decides whether to retry a request, using the policy of the request's operation.
+func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	state := operationStateFrom(ctx)
+	if state == nil {
+		return core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+	}
+	retry, checkErr := core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+	if !retry {
+		return retry, checkErr
+	}
+
+	policy := state.retryPolicy
+	if policy.Mode == "" {
+		policy.Mode = defaultRetryPolicy(state.method).Mode
+	}
+	switch policy.Mode {
+	case RetryPolicy_Mode_Always:
+	case RetryPolicy_Mode_IfNotSent:
+		if resp != nil || state.sent.Load() {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+	if policy.MaxRetries > 0 && int(state.retries.Load()) >= policy.MaxRetries {
+		return false, nil
+	}
+	return true, nil
+}
+
+// retryBackoff returns the wait before a retry. It honours a Retry-After header, in seconds or as a
+// date, and otherwise backs off exponentially from min up to max. Jitter is added so that clients
+// that failed together do not retry together.
+func retryBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait + jitter(wait/10)
+		}
+	}
+
+	wait := time.Duration(float64(min) * math.Pow(2, float64(attemptNum)))
+	if wait <= 0 || wait > max {
+		wait = max
+	}
+	return wait/2 + jitter(wait/2)
+}
+
+// retryAfter parses a Retry-After header.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// jitter returns a random duration between zero and limit.
+func jitter(limit time.Duration) time.Duration {
+	if limit <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(limit) + 1))
+}
@@ -0,0 +1,186 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// failingDialTransport fails the first attempts as if the connection could not be made.
+type failingDialTransport struct {
+	failures int32
+	attempts atomic.Int32
+}
+
+func (transport *failingDialTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if transport.attempts.Add(1) <= transport.failures {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+var _ = Describe(`RetryPolicy`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		injector       *mqcloudtest.FaultInjector
+		mqcloudService *mqcloudv1.MqcloudV1
+	)
+
+	// start serves the emulator behind the fault rules and returns a client with retries enabled.
+	start := func(rules ...mqcloudtest.FaultRule) {
+		emulator := mqcloudtest.NewServer(nil)
+		emulator.AddServiceInstance(serviceInstanceGuid)
+		injector = mqcloudtest.NewFaultInjector(emulator, &mqcloudtest.FaultOptions{Seed: 1, Rules: rules})
+		testServer = httptest.NewServer(injector)
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		mqcloudService.EnableRetries(3, 10*time.Millisecond)
+	}
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Retries reads but not creates after a server error`, func() {
+		start(mqcloudtest.FaultRule{Statuses: []int{503, 0, 503}})
+
+		_, response, err := mqcloudService.GetOptions(mqcloudService.NewGetOptionsOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(injector.Stats().Requests).To(Equal(2))
+
+		_, response, err = mqcloudService.CreateApplication(mqcloudService.NewCreateApplicationOptions(serviceInstanceGuid, "orders"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(injector.Stats().Requests).To(Equal(3))
+	})
+	It(`Does not retry creates after a dropped connection`, func() {
+		start()
+		var requests atomic.Int32
+		testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests.Add(1)
+			conn, _, err := res.(http.Hijacker).Hijack()
+			Expect(err).To(BeNil())
+			conn.Close()
+		})
+
+		_, _, err := mqcloudService.CreateApplication(mqcloudService.NewCreateApplicationOptions(serviceInstanceGuid, "orders"))
+		Expect(err).ToNot(BeNil())
+		Expect(requests.Load()).To(Equal(int32(1)))
+
+		_, _, err = mqcloudService.ListApplications(mqcloudService.NewListApplicationsOptions(serviceInstanceGuid))
+		Expect(err).ToNot(BeNil())
+		Expect(requests.Load()).To(Equal(int32(5)))
+
+		mqcloudService.SetRetryPolicy("ListApplications", &mqcloudv1.RetryPolicy{Mode: mqcloudv1.RetryPolicy_Mode_Always, MaxRetries: 1})
+		_, _, err = mqcloudService.ListApplications(mqcloudService.NewListApplicationsOptions(serviceInstanceGuid))
+		Expect(err).ToNot(BeNil())
+		Expect(requests.Load()).To(Equal(int32(7)))
+	})
+	It(`Retries creates that never reached the server`, func() {
+		start()
+		transport := &failingDialTransport{failures: 2}
+		mqcloudService.DisableRetries()
+		mqcloudService.Service.SetHTTPClient(&http.Client{Transport: transport})
+		mqcloudService.EnableRetries(3, 10*time.Millisecond)
+
+		created, response, err := mqcloudService.CreateApplication(mqcloudService.NewCreateApplicationOptions(serviceInstanceGuid, "orders"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*created.Name).To(Equal("orders"))
+		Expect(transport.attempts.Load()).To(Equal(int32(3)))
+	})
+	It(`Applies the policies to retries enabled by external configuration`, func() {
+		start(mqcloudtest.FaultRule{Statuses: []int{503, 503, 503}})
+		os.Setenv("MQCLOUD_AUTH_TYPE", "noauth")
+		os.Setenv("MQCLOUD_URL", testServer.URL)
+		os.Setenv("MQCLOUD_ENABLE_RETRIES", "true")
+		os.Setenv("MQCLOUD_MAX_RETRY_INTERVAL", "1")
+		defer func() {
+			os.Unsetenv("MQCLOUD_AUTH_TYPE")
+			os.Unsetenv("MQCLOUD_URL")
+			os.Unsetenv("MQCLOUD_ENABLE_RETRIES")
+			os.Unsetenv("MQCLOUD_MAX_RETRY_INTERVAL")
+		}()
+		configured, err := mqcloudv1.NewMqcloudV1UsingExternalConfig(&mqcloudv1.MqcloudV1Options{})
+		Expect(err).To(BeNil())
+
+		_, response, err := configured.CreateApplication(configured.NewCreateApplicationOptions(serviceInstanceGuid, "orders"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(injector.Stats().Requests).To(Equal(1))
+	})
+	It(`Applies the policies to retries enabled on the base service`, func() {
+		start(mqcloudtest.FaultRule{Statuses: []int{503, 503, 503}})
+		mqcloudService.DisableRetries()
+		mqcloudService.Service.EnableRetries(3, 10*time.Millisecond)
+
+		_, response, err := mqcloudService.CreateApplication(mqcloudService.NewCreateApplicationOptions(serviceInstanceGuid, "orders"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(injector.Stats().Requests).To(Equal(1))
+	})
+	It(`Applies per-operation policies, also to clones`, func() {
+		start(mqcloudtest.FaultRule{Statuses: []int{500, 0, 500, 500}})
+		mqcloudService.SetRetryPolicy("CreateApplication", &mqcloudv1.RetryPolicy{Mode: mqcloudv1.RetryPolicy_Mode_Always})
+		mqcloudService.SetRetryPolicy("GetOptions", &mqcloudv1.RetryPolicy{Mode: mqcloudv1.RetryPolicy_Mode_Never})
+		Expect(mqcloudService.GetRetryPolicy("CreateApplication").Mode).To(Equal(mqcloudv1.RetryPolicy_Mode_Always))
+		clone := mqcloudService.Clone()
+		clone.SetRetryPolicy("GetOptions", nil)
+		Expect(mqcloudService.GetRetryPolicy("GetOptions").Mode).To(Equal(mqcloudv1.RetryPolicy_Mode_Never))
+
+		_, response, err := clone.CreateApplication(clone.NewCreateApplicationOptions(serviceInstanceGuid, "orders"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(injector.Stats().Requests).To(Equal(2))
+
+		_, response, err = mqcloudService.GetOptions(mqcloudService.NewGetOptionsOptions(serviceInstanceGuid))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(500))
+		Expect(injector.Stats().Requests).To(Equal(3))
+
+		_, response, err = clone.GetOptions(clone.NewGetOptionsOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(injector.Stats().Requests).To(Equal(5))
+	})
+	It(`Honours Retry-After`, func() {
+		start(mqcloudtest.FaultRule{Statuses: []int{429}, RetryAfter: time.Second})
+
+		started := time.Now()
+		_, response, err := mqcloudService.GetUsageDetails(mqcloudService.NewGetUsageDetailsOptions(serviceInstanceGuid))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(time.Since(started)).To(BeNumerically(">=", time.Second))
+	})
+})
@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Redacted is what a Secret prints as.
+const Redacted = "[redacted]"
+
+// Secret : A sensitive string, such as an api key, that does not reveal its value when printed.
+// String, GoString, Format and MarshalJSON all produce Redacted; use Reveal to get the value.
+// Because MarshalJSON redacts, marshalling a model that holds a Secret drops the value without an
+// error: code that persists or forwards such a model must copy the value out with Reveal.
+type Secret string
+
+// SecretPtr returns a pointer to a Secret holding value.
+func SecretPtr(value string) *Secret {
+	secret := Secret(value)
+	return &secret
+}
+
+// Reveal returns the secret value.
+func (secret Secret) Reveal() string {
+	return string(secret)
+}
+
+// String implements fmt.Stringer.
+func (secret Secret) String() string {
+	return Redacted
+}
+
+// GoString implements fmt.GoStringer, for the %#v verb.
+func (secret Secret) GoString() string {
+	return Redacted
+}
+
+// Format implements fmt.Formatter so that every verb, including %q and %x, is redacted.
+func (secret Secret) Format(state fmt.State, verb rune) {
+	_, _ = fmt.Fprint(state, Redacted)
+}
+
+// MarshalJSON implements json.Marshaler, so that models and DetailedResponse.String() do not leak the
+// value. It always produces Redacted, never the value.
+func (secret Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Redacted)
+}
+
+// reAPIKeyField matches the api_key field of a JSON response body.
+var reAPIKeyField = regexp.MustCompile(`("api_key"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// redactAPIKey removes the api key from whatever parts of a response still hold it in raw form: the
+// body kept in RawResult when it could not be decoded, and the raw field map left in Result when it
+// could not be unmarshalled into a model.
+func redactAPIKey(response *core.DetailedResponse) {
+	if response == nil {
+		return
+	}
+	if response.RawResult != nil {
+		response.RawResult = reAPIKeyField.ReplaceAll(response.RawResult, []byte(`${1}"`+Redacted+`"`))
+	}
+	switch result := response.Result.(type) {
+	case *map[string]json.RawMessage:
+		if result != nil {
+			redactAPIKeyField(*result)
+		}
+	case map[string]json.RawMessage:
+		redactAPIKeyField(result)
+	case map[string]interface{}:
+		if _, ok := result["api_key"]; ok {
+			result["api_key"] = Redacted
+		}
+	}
+}
+
+func redactAPIKeyField(fields map[string]json.RawMessage) {
+	if _, ok := fields["api_key"]; ok {
+		fields["api_key"], _ = json.Marshal(Redacted)
+	}
+}
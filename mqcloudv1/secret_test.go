@@ -0,0 +1,102 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Secret`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	const apiKey = "s3cr3t-api-key-value"
+
+	It(`Redacts the value however it is printed`, func() {
+		secret := mqcloudv1.SecretPtr(apiKey)
+		for _, format := range []string{"%s", "%v", "%+v", "%#v", "%q", "%x", "%10s"} {
+			Expect(fmt.Sprintf(format, *secret)).ToNot(ContainSubstring(apiKey))
+		}
+		model := mqcloudv1.ApplicationAPIKeyCreated{ApiKey: secret}
+		Expect(fmt.Sprintf("%+v %#v", model, model)).ToNot(ContainSubstring(apiKey))
+		data, err := json.Marshal(model)
+		Expect(err).To(BeNil())
+		Expect(string(data)).ToNot(ContainSubstring(apiKey))
+		Expect(secret.Reveal()).To(Equal(apiKey))
+	})
+
+	Describe(`In responses`, func() {
+		var (
+			testServer     *httptest.Server
+			mqcloudService *mqcloudv1.MqcloudV1
+			body           string
+			logOutput      bytes.Buffer
+			savedLogger    core.Logger
+		)
+
+		BeforeEach(func() {
+			body = fmt.Sprintf(`{"id":"app1","name":"app1","create_api_key_uri":"u","href":"h","api_key_name":"k","api_key_id":"k1","api_key":"%s"}`, apiKey)
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(201)
+				fmt.Fprint(res, body)
+			}))
+
+			var err error
+			mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			logOutput.Reset()
+			savedLogger = core.GetLogger()
+			logger := log.New(&logOutput, "", 0)
+			core.SetLogger(core.NewLogger(core.LevelDebug, logger, logger))
+		})
+		AfterEach(func() {
+			core.SetLogger(savedLogger)
+			testServer.Close()
+		})
+
+		It(`Keeps the api key out of results and debug logs`, func() {
+			result, response, err := mqcloudService.CreateApplication(mqcloudService.NewCreateApplicationOptions(serviceInstanceGuid, "app1"))
+			Expect(err).To(BeNil())
+			Expect(result.ApiKey.Reveal()).To(Equal(apiKey))
+			Expect(fmt.Sprintf("%+v", result)).ToNot(ContainSubstring(apiKey))
+			Expect(response.String()).ToNot(ContainSubstring(apiKey))
+			Expect(logOutput.String()).To(ContainSubstring("Response:"))
+			Expect(logOutput.String()).ToNot(ContainSubstring(apiKey))
+		})
+		It(`Scrubs the raw result when the body cannot be unmarshalled`, func() {
+			body = fmt.Sprintf(`{"api_key_id":"k1","api_key":"%s","api_key_name":7}`, apiKey)
+			_, response, err := mqcloudService.CreateApplicationApikey(mqcloudService.NewCreateApplicationApikeyOptions(serviceInstanceGuid, "app1", "k"))
+			Expect(err).ToNot(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(response.String()).ToNot(ContainSubstring(apiKey))
+			Expect(fmt.Sprintf("%v", err)).ToNot(ContainSubstring(apiKey))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+)
+
+// SpanStart : What a Tracer is told about a span when it starts.
+type SpanStart struct {
+	// The name of the span: the operation ID for operations, such as "CreateQueueManager", or the
+	// method name for workflows, such as "TrustMesh".
+	Name string
+
+	// True for the span of an operation, which makes one HTTP request; false for the span of a
+	// workflow that calls several operations.
+	Operation bool
+
+	// The HTTP method of an operation's request. Empty for workflows.
+	Method string
+
+	// The queue manager the span is for. Either ID may be empty.
+	QueueManager QueueManagerRef
+}
+
+// SpanEnd : What a Span is told when it ends.
+type SpanEnd struct {
+	// The HTTP status code of an operation's final response, or 0 if there was none.
+	StatusCode int
+
+	// The number of times the HTTP client retried an operation's request.
+	Retries int
+
+	// The error of the operation or workflow, or nil.
+	Err error
+}
+
+// Tracer : Starts spans for the operations and workflows of a client.
+// See package mqcloudotel for an OpenTelemetry implementation. Implementations must be safe for
+// concurrent use.
+type Tracer interface {
+	// Start starts a span as a child of any span in ctx, and returns a context holding the new span.
+	Start(ctx context.Context, start SpanStart) (context.Context, Span)
+
+	// Inject writes the trace context of ctx into the headers of an operation's request.
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Span : A span started by a Tracer.
+type Span interface {
+	// Retry is called when the HTTP client retries an operation's request. The attempt is 1 for the
+	// first retry.
+	Retry(attempt int)
+
+	// End ends the span.
+	End(end SpanEnd)
+}
+
+// SetTracer sets the tracer that starts a span for every operation, as a child of any span in the
+// operation's context, and propagates the span's context to the service in the request headers.
+// Workflows such as TrustMesh and ImportUsers, and the wait made by EnableTransitionRetries, get a
+// span of their own as the parent of the spans of the operations they call. Calling it with nil
+// stops the tracing. The tracer is shared with clones made afterwards.
+func (mqcloud *MqcloudV1) SetTracer(tracer Tracer) {
+	mqcloud.tracer = tracer
+}
+
+// GetTracer returns the tracer set with SetTracer, or nil.
+func (mqcloud *MqcloudV1) GetTracer() Tracer {
+	return mqcloud.tracer
+}
+
+// startOperation starts the span of an operation and returns the request with the span in its
+// context and the trace context in its headers.
+func (mqcloud *MqcloudV1) startOperation(state *operationState, options interface{}, req *http.Request) *http.Request {
+	ref := queueManagerOf(options)
+	if ref.ServiceInstanceGuid == "" {
+		ref.ServiceInstanceGuid = serviceInstanceGuidOf(options)
+	}
+	ctx, span := mqcloud.tracer.Start(req.Context(), SpanStart{
+		Name:         state.id,
+		Operation:    true,
+		Method:       req.Method,
+		QueueManager: ref,
+	})
+	state.span = span
+	req = req.WithContext(ctx)
+	mqcloud.tracer.Inject(ctx, req.Header)
+	return req
+}
+
+// startSpan starts the span of a workflow that calls several operations. The span is a child of any
+// span in ctx, and the parent of the spans of the operations called with the returned context. The
+// returned function ends the span with the workflow's error. Without a tracer, ctx is returned as is.
+func (mqcloud *MqcloudV1) startSpan(ctx context.Context, name string, ref QueueManagerRef) (context.Context, func(error)) {
+	if mqcloud.tracer == nil {
+		return ctx, func(error) {}
+	}
+	ctx, span := mqcloud.tracer.Start(ctx, SpanStart{Name: name, QueueManager: ref})
+	return ctx, func(err error) {
+		span.End(SpanEnd{Err: err})
+	}
+}
+
+// serviceInstanceGuidOf returns the ServiceInstanceGuid of an operation's options, or "" if they
+// have none.
+func serviceInstanceGuidOf(options interface{}) string {
+	value := reflect.ValueOf(options)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return ""
+	}
+	field := value.Elem().FieldByName("ServiceInstanceGuid")
+	if field.Kind() == reflect.Ptr && !field.IsNil() {
+		field = field.Elem()
+	}
+	if field.Kind() == reflect.String {
+		return field.String()
+	}
+	return ""
+}
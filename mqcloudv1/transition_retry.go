@@ -0,0 +1,165 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Defaults for TransitionRetryOptions.
+const (
+	DefaultTransitionRetryTimeout      = 15 * time.Minute
+	DefaultTransitionRetryPollInterval = 10 * time.Second
+)
+
+// transitionRetryOperations are the operations that are resubmitted by transition retries.
+var transitionRetryOperations = map[string]bool{
+	"SetQueueManagerVersion":         true,
+	"DeleteQueueManager":             true,
+	"CreateTrustStorePemCertificate": true,
+	"CreateKeyStorePemCertificate":   true,
+}
+
+// transitionalStatuses are the queue manager statuses that end by themselves.
+var transitionalStatuses = map[string]bool{
+	QueueManagerStatus_Status_Deploying:             true,
+	QueueManagerStatus_Status_Initializing:          true,
+	QueueManagerStatus_Status_RestoringConfig:       true,
+	QueueManagerStatus_Status_RestoringQueueManager: true,
+	QueueManagerStatus_Status_Starting:              true,
+	QueueManagerStatus_Status_Stopping:              true,
+	QueueManagerStatus_Status_UpdatingRevision:      true,
+	QueueManagerStatus_Status_UpgradingVersion:      true,
+}
+
+// TransitionRetryOptions : Settings for EnableTransitionRetries.
+type TransitionRetryOptions struct {
+	// The longest a call waits for the queue manager and resubmits, from the first attempt.
+	// Defaults to DefaultTransitionRetryTimeout.
+	Timeout time.Duration
+
+	// How often the queue manager status is checked while waiting.
+	// Defaults to DefaultTransitionRetryPollInterval.
+	PollInterval time.Duration
+}
+
+// EnableTransitionRetries makes SetQueueManagerVersion, DeleteQueueManager,
+// CreateTrustStorePemCertificate and CreateKeyStorePemCertificate wait out a queue manager that is
+// deploying, updating its revision, upgrading or in another transitional state. When one of these
+// calls is rejected with a 409 Conflict, the queue manager status is polled with
+// GetQueueManagerStatus until it is stable and the call is resubmitted, until it succeeds, fails for
+// another reason or the timeout passes. The setting is copied by Clone.
+func (mqcloud *MqcloudV1) EnableTransitionRetries(options *TransitionRetryOptions) {
+	settings := TransitionRetryOptions{}
+	if options != nil {
+		settings = *options
+	}
+	if settings.Timeout <= 0 {
+		settings.Timeout = DefaultTransitionRetryTimeout
+	}
+	if settings.PollInterval <= 0 {
+		settings.PollInterval = DefaultTransitionRetryPollInterval
+	}
+	mqcloud.transitionRetry = &settings
+}
+
+// DisableTransitionRetries turns off the waiting set up by EnableTransitionRetries.
+func (mqcloud *MqcloudV1) DisableTransitionRetries() {
+	mqcloud.transitionRetry = nil
+}
+
+// requestWithTransitionRetry sends a request, resubmitting it after a conflict once the queue manager
+// leaves a transitional state.
+func (mqcloud *MqcloudV1) requestWithTransitionRetry(state *operationState, options interface{}, req *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	ref := queueManagerOf(options)
+	settings := *mqcloud.transitionRetry
+
+	// The body is read into memory so that it can be sent again. This includes multipart bodies,
+	// which the core streams through a pipe that can only be read once.
+	var body []byte
+	if req.Body != nil {
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), settings.Timeout)
+	defer cancel()
+
+	// A conflict can arrive just after the queue manager left a transitional state, so the request
+	// is resubmitted once even if the queue manager was stable when first checked.
+	resubmittedWhileStable := false
+	for {
+		attempt := req.Clone(ctx)
+		if body != nil {
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+			attempt.ContentLength = int64(len(body))
+			attempt.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+		response, err = mqcloud.send(state, attempt, result)
+		if err == nil || response == nil || response.StatusCode != http.StatusConflict || ref.QueueManagerID == "" || ctx.Err() != nil {
+			return
+		}
+		waited, ok := mqcloud.waitForStableStatus(ctx, settings.PollInterval, ref)
+		if !ok || ctx.Err() != nil {
+			return
+		}
+		if !waited {
+			if resubmittedWhileStable {
+				return
+			}
+			resubmittedWhileStable = true
+		}
+	}
+}
+
+// waitForStableStatus waits until a queue manager is not in a transitional state. It returns whether
+// it had to wait, and false for ok if the status could not be found before ctx ended.
+func (mqcloud *MqcloudV1) waitForStableStatus(ctx context.Context, pollInterval time.Duration, ref QueueManagerRef) (waited bool, ok bool) {
+	ctx, end := mqcloud.startSpan(ctx, "WaitForStableStatus", ref)
+	defer func() {
+		end(ctx.Err())
+	}()
+
+	for {
+		status, _, err := mqcloud.GetQueueManagerStatusWithContext(ctx, mqcloud.NewGetQueueManagerStatusOptions(ref.ServiceInstanceGuid, ref.QueueManagerID))
+		if err != nil || status.Status == nil {
+			return waited, false
+		}
+		if !transitionalStatuses[*status.Status] {
+			return waited, true
+		}
+		waited = true
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return waited, false
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/devpki"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudtest"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnableTransitionRetries`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		emulator       *mqcloudtest.Server
+		clock          *mqcloudtest.ManualClock
+		queueManagerID string
+		statusPolls    atomic.Int32
+		conflicts      atomic.Int32
+		ca             *devpki.CA
+		dir            string
+	)
+
+	BeforeEach(func() {
+		// Each status poll moves the emulator's clock on by a minute, so deployments finish after a
+		// few polls without real waiting.
+		clock = mqcloudtest.NewManualClock(time.Now())
+		emulator = mqcloudtest.NewServer(&mqcloudtest.Options{Clock: clock})
+		emulator.AddServiceInstance(serviceInstanceGuid)
+		statusPolls.Store(0)
+		conflicts.Store(0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodPost && conflicts.Add(-1) >= 0 {
+				res.Header().Set("Content-Type", "application/json")
+				res.WriteHeader(http.StatusConflict)
+				_, _ = io.WriteString(res, `{"errors":[{"code":"queue_manager_busy","message":"the queue manager is updating"}]}`)
+				return
+			}
+			if req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/status") {
+				statusPolls.Add(1)
+				clock.Advance(time.Minute)
+			}
+			emulator.ServeHTTP(res, req)
+		}))
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		createOptions := mqcloudService.NewCreateQueueManagerOptions(serviceInstanceGuid, "QM1", "reserved-eu-de-cluster-f884", "small")
+		task, _, err := mqcloudService.CreateQueueManager(createOptions.SetVersion("9.3.4_2"))
+		Expect(err).To(BeNil())
+		queueManagerID = *task.QueueManagerID
+
+		dir, err = os.MkdirTemp("", "transition")
+		Expect(err).To(BeNil())
+		ca, err = devpki.NewCA(filepath.Join(dir, "ca"), nil)
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(dir)
+	})
+
+	upload := func(label string) (*core.DetailedResponse, error) {
+		certificate := io.NopCloser(bytes.NewReader(ca.CertificatePEM()))
+		_, response, err := mqcloudService.CreateTrustStorePemCertificate(mqcloudService.NewCreateTrustStorePemCertificateOptions(serviceInstanceGuid, queueManagerID, label, certificate))
+		return response, err
+	}
+
+	It(`Leaves conflicts to the caller unless enabled`, func() {
+		response, err := upload("ca")
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+		Expect(mqcloudv1.IsInvalidState(err)).To(BeTrue())
+		Expect(statusPolls.Load()).To(Equal(int32(0)))
+	})
+	It(`Waits for a deploying queue manager and resubmits uploads`, func() {
+		mqcloudService.EnableTransitionRetries(&mqcloudv1.TransitionRetryOptions{PollInterval: time.Millisecond})
+
+		response, err := upload("ca")
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(statusPolls.Load()).To(BeNumerically(">", 1))
+
+		status, _, err := mqcloudService.GetQueueManagerStatus(mqcloudService.NewGetQueueManagerStatusOptions(serviceInstanceGuid, queueManagerID))
+		Expect(err).To(BeNil())
+		Expect(*status.Status).To(Equal(mqcloudv1.QueueManagerStatus_Status_Running))
+
+		polls := statusPolls.Load()
+		response, err = upload("ca")
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+		Expect(mqcloudv1.IsInvalidState(err)).To(BeFalse())
+		Expect(statusPolls.Load()).To(Equal(polls + 2))
+	})
+	It(`Resubmits once after a conflict that raced the end of a transition`, func() {
+		mqcloudService.EnableTransitionRetries(&mqcloudv1.TransitionRetryOptions{PollInterval: time.Millisecond})
+		clock.Advance(mqcloudtest.DefaultDeployDuration + mqcloudtest.DefaultStartDuration)
+		conflicts.Store(1)
+
+		response, err := upload("ca")
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(statusPolls.Load()).To(Equal(int32(1)))
+	})
+	It(`Waits out upgrades before deleting, also for clones`, func() {
+		mqcloudService.EnableTransitionRetries(&mqcloudv1.TransitionRetryOptions{PollInterval: time.Millisecond})
+		clone := mqcloudService.Clone()
+		clock.Advance(mqcloudtest.DefaultDeployDuration + mqcloudtest.DefaultStartDuration)
+		_, response, err := mqcloudService.SetQueueManagerVersion(mqcloudService.NewSetQueueManagerVersionOptions(serviceInstanceGuid, queueManagerID, "9.3.5_1"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+
+		_, response, err = clone.DeleteQueueManager(clone.NewDeleteQueueManagerOptions(serviceInstanceGuid, queueManagerID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(statusPolls.Load()).To(BeNumerically(">", 1))
+	})
+	It(`Gives up at the timeout`, func() {
+		mqcloudService.EnableTransitionRetries(&mqcloudv1.TransitionRetryOptions{Timeout: 20 * time.Millisecond, PollInterval: time.Millisecond})
+		Expect(emulator.SetQueueManagerStatus(serviceInstanceGuid, queueManagerID, mqcloudv1.QueueManagerStatus_Status_UpdatingRevision)).To(Succeed())
+
+		_, response, err := mqcloudService.SetQueueManagerVersion(mqcloudService.NewSetQueueManagerVersionOptions(serviceInstanceGuid, queueManagerID, "9.3.5_1"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+		Expect(statusPolls.Load()).To(BeNumerically(">", 1))
+
+		mqcloudService.DisableTransitionRetries()
+		response, err = upload("ca")
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+	})
+})
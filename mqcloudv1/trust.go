@@ -0,0 +1,319 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// QueueManagerRef : Identifies a queue manager within a service instance.
+type QueueManagerRef struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid string
+
+	// The id of the queue manager.
+	QueueManagerID string
+}
+
+// String returns the reference in "service_instance_guid/queue_manager_id" form.
+func (ref QueueManagerRef) String() string {
+	return ref.ServiceInstanceGuid + "/" + ref.QueueManagerID
+}
+
+// TrustChange : A certificate from one queue manager's default key store chain as seen by a peer's trust store.
+type TrustChange struct {
+	// The queue manager whose default key store certificate chain the certificate belongs to.
+	Source QueueManagerRef
+
+	// The queue manager whose trust store was checked.
+	Target QueueManagerRef
+
+	// The label of the certificate in the target trust store.
+	Label string
+
+	// Normalized (lower case hex, no separators) SHA256 fingerprint of the certificate.
+	FingerprintSha256 string
+
+	// Subject's Distinguished Name.
+	SubjectDn string
+
+	// The id of the certificate in the target trust store.
+	CertificateID string
+}
+
+// TrustReport : The outcome of a TrustEachOther or TrustMesh call.
+type TrustReport struct {
+	// Certificates that were uploaded to a trust store.
+	Added []TrustChange
+
+	// Certificates that were already present in a trust store and left untouched.
+	AlreadyTrusted []TrustChange
+}
+
+// Changed returns true if any trust store was modified.
+func (report *TrustReport) Changed() bool {
+	return len(report.Added) > 0
+}
+
+// TrustEachOther : Make two queue managers trust each other's default key store certificate chain
+// Downloads the default key store certificate chain of each queue manager and uploads any certificate
+// that is missing from the other queue manager's trust store. Certificates are matched by SHA256
+// fingerprint, so calling it again once both sides trust each other makes no changes.
+func (mqcloud *MqcloudV1) TrustEachOther(qmA QueueManagerRef, qmB QueueManagerRef) (report *TrustReport, err error) {
+	report, err = mqcloud.TrustEachOtherWithContext(context.Background(), qmA, qmB)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// TrustEachOtherWithContext is an alternate form of the TrustEachOther method which supports a Context parameter
+func (mqcloud *MqcloudV1) TrustEachOtherWithContext(ctx context.Context, qmA QueueManagerRef, qmB QueueManagerRef) (report *TrustReport, err error) {
+	return mqcloud.TrustMeshWithContext(ctx, []QueueManagerRef{qmA, qmB})
+}
+
+// TrustMesh : Make every queue manager in the list trust every other one
+// Applies TrustEachOther to each pair of queue managers, producing a full trust mesh.
+func (mqcloud *MqcloudV1) TrustMesh(queueManagers []QueueManagerRef) (report *TrustReport, err error) {
+	report, err = mqcloud.TrustMeshWithContext(context.Background(), queueManagers)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// TrustMeshWithContext is an alternate form of the TrustMesh method which supports a Context parameter
+func (mqcloud *MqcloudV1) TrustMeshWithContext(ctx context.Context, queueManagers []QueueManagerRef) (report *TrustReport, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "TrustMesh", QueueManagerRef{})
+	defer func() {
+		end(err)
+	}()
+
+	var members []QueueManagerRef
+	seen := make(map[QueueManagerRef]bool)
+	for _, qm := range queueManagers {
+		if qm.ServiceInstanceGuid == "" || qm.QueueManagerID == "" {
+			err = core.SDKErrorf(nil, "queue manager references must have a service instance guid and a queue manager id", "invalid-queue-manager-ref", common.GetComponentInfo())
+			return
+		}
+		if !seen[qm] {
+			seen[qm] = true
+			members = append(members, qm)
+		}
+	}
+	if len(members) < 2 {
+		err = core.SDKErrorf(nil, "at least two distinct queue managers are required", "too-few-queue-managers", common.GetComponentInfo())
+		return
+	}
+
+	chains := make(map[QueueManagerRef][]*x509.Certificate)
+	trusted := make(map[QueueManagerRef]map[string]trustStoreEntry)
+	for _, qm := range members {
+		chains[qm], err = mqcloud.defaultKeyStoreChain(ctx, qm)
+		if err != nil {
+			return
+		}
+		trusted[qm], err = mqcloud.trustStoreEntries(ctx, qm)
+		if err != nil {
+			return
+		}
+	}
+
+	report = &TrustReport{}
+	for _, target := range members {
+		for _, source := range members {
+			if source == target {
+				continue
+			}
+			for _, cert := range chains[source] {
+				fingerprint := CertificateFingerprint(cert)
+				change := TrustChange{
+					Source:            source,
+					Target:            target,
+					FingerprintSha256: fingerprint,
+					SubjectDn:         cert.Subject.String(),
+				}
+				if entry, ok := trusted[target][fingerprint]; ok {
+					change.Label = entry.label
+					change.CertificateID = entry.id
+					report.AlreadyTrusted = append(report.AlreadyTrusted, change)
+					continue
+				}
+
+				change.Label = trustLabel(cert, fingerprint)
+				var created *TrustStoreCertificateDetails
+				created, _, err = mqcloud.CreateTrustStorePemCertificateWithContext(ctx, &CreateTrustStorePemCertificateOptions{
+					ServiceInstanceGuid: core.StringPtr(target.ServiceInstanceGuid),
+					QueueManagerID:      core.StringPtr(target.QueueManagerID),
+					Label:               core.StringPtr(change.Label),
+					CertificateFile:     io.NopCloser(bytes.NewReader(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))),
+				})
+				if err != nil {
+					err = core.RepurposeSDKProblem(err, "trust-store-upload-error")
+					return
+				}
+				if created != nil && created.ID != nil {
+					change.CertificateID = *created.ID
+				}
+				trusted[target][fingerprint] = trustStoreEntry{id: change.CertificateID, label: change.Label}
+				report.Added = append(report.Added, change)
+			}
+		}
+	}
+
+	return
+}
+
+// trustStoreEntry is the part of a trust store certificate needed to report on it.
+type trustStoreEntry struct {
+	id    string
+	label string
+}
+
+// defaultKeyStoreChain downloads and parses the certificate chain of the queue manager's default key store certificate.
+func (mqcloud *MqcloudV1) defaultKeyStoreChain(ctx context.Context, qm QueueManagerRef) (chain []*x509.Certificate, err error) {
+	keyStore, _, err := mqcloud.ListKeyStoreCertificatesWithContext(ctx, &ListKeyStoreCertificatesOptions{
+		ServiceInstanceGuid: core.StringPtr(qm.ServiceInstanceGuid),
+		QueueManagerID:      core.StringPtr(qm.QueueManagerID),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "key-store-list-error")
+		return
+	}
+
+	var certificateID *string
+	for _, cert := range keyStore.KeyStore {
+		if cert.IsDefault != nil && *cert.IsDefault {
+			certificateID = cert.ID
+			break
+		}
+	}
+	if certificateID == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("queue manager %s has no default key store certificate", qm), "no-default-certificate", common.GetComponentInfo())
+		return
+	}
+
+	download, _, err := mqcloud.DownloadKeyStoreCertificateWithContext(ctx, &DownloadKeyStoreCertificateOptions{
+		ServiceInstanceGuid: core.StringPtr(qm.ServiceInstanceGuid),
+		QueueManagerID:      core.StringPtr(qm.QueueManagerID),
+		CertificateID:       certificateID,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "key-store-download-error")
+		return
+	}
+	defer download.Close()
+
+	data, err := io.ReadAll(download)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "key-store-read-error", common.GetComponentInfo())
+		return
+	}
+	chain, err = parsePEMCertificates(data)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("default key store certificate of queue manager %s: %s", qm, err.Error()), "key-store-parse-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// trustStoreEntries returns the queue manager's trust store certificates indexed by normalized fingerprint.
+func (mqcloud *MqcloudV1) trustStoreEntries(ctx context.Context, qm QueueManagerRef) (entries map[string]trustStoreEntry, err error) {
+	trustStore, _, err := mqcloud.ListTrustStoreCertificatesWithContext(ctx, &ListTrustStoreCertificatesOptions{
+		ServiceInstanceGuid: core.StringPtr(qm.ServiceInstanceGuid),
+		QueueManagerID:      core.StringPtr(qm.QueueManagerID),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "trust-store-list-error")
+		return
+	}
+
+	entries = make(map[string]trustStoreEntry)
+	for _, cert := range trustStore.TrustStore {
+		if cert.FingerprintSha256 == nil {
+			continue
+		}
+		entries[NormalizeFingerprint(*cert.FingerprintSha256)] = trustStoreEntry{
+			id:    core.StringNilMapper(cert.ID),
+			label: core.StringNilMapper(cert.Label),
+		}
+	}
+	return
+}
+
+// parsePEMCertificates returns every CERTIFICATE block in data, in order.
+func parsePEMCertificates(data []byte) (certs []*x509.Certificate, err error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		var cert *x509.Certificate
+		cert, err = x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		err = fmt.Errorf("no PEM encoded certificates found")
+	}
+	return
+}
+
+// CertificateFingerprint returns the SHA256 fingerprint of cert in the form NormalizeFingerprint
+// produces.
+func CertificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// NormalizeFingerprint lower cases a fingerprint and strips the separators that the service or
+// other tools may include, so that fingerprints from different sources can be compared.
+func NormalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.NewReplacer(":", "", " ", "", "-", "").Replace(fingerprint))
+}
+
+// trustLabel derives a stable trust store label from the certificate's common name and fingerprint.
+func trustLabel(cert *x509.Certificate, fingerprint string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		}
+		return '_'
+	}, cert.Subject.CommonName)
+	if len(name) > 32 {
+		name = name[:32]
+	}
+	if name == "" {
+		name = "peer"
+	}
+	return name + "_" + fingerprint[:12]
+}
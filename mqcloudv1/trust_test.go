@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newTestCertificate returns a self-signed certificate, PEM encoded, and its SHA256 fingerprint.
+func newTestCertificate(commonName string, dnsNames ...string) (certPEM []byte, fingerprint string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).To(BeNil())
+	sum := sha256.Sum256(der)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), hex.EncodeToString(sum[:])
+}
+
+// trustTestQueueManager is the state of one queue manager in the trust test server.
+type trustTestQueueManager struct {
+	defaultPEM []byte
+	trustStore []map[string]interface{}
+	uploads    int
+}
+
+var _ = Describe(`TrustEachOther`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		testServer     *httptest.Server
+		mqcloudService *mqcloudv1.MqcloudV1
+		queueManagers  map[string]*trustTestQueueManager
+		fingerprints   map[string]string
+		mutex          sync.Mutex
+	)
+
+	BeforeEach(func() {
+		queueManagers = map[string]*trustTestQueueManager{}
+		fingerprints = map[string]string{}
+		for _, id := range []string{"qma", "qmb", "qmc"} {
+			certPEM, fingerprint := newTestCertificate(id + ".example.com")
+			queueManagers[id] = &trustTestQueueManager{defaultPEM: certPEM}
+			fingerprints[id] = fingerprint
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			parts := strings.Split(strings.TrimPrefix(req.URL.Path, "/v1/"+serviceInstanceGuid+"/queue_managers/"), "/")
+			qm := queueManagers[parts[0]]
+			Expect(qm).ToNot(BeNil())
+			path := strings.Join(parts[1:], "/")
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && path == "certificates/key_store":
+				fmt.Fprintf(res, `{"total_count":2,"key_store":[{"id":"other","is_default":false},{"id":"default","is_default":true}]}`)
+			case req.Method == "GET" && path == "certificates/key_store/default/download":
+				res.Header().Set("Content-type", "application/octet-stream")
+				_, _ = res.Write(qm.defaultPEM)
+			case req.Method == "GET" && path == "certificates/trust_store":
+				body, _ := json.Marshal(map[string]interface{}{"total_count": len(qm.trustStore), "trust_store": qm.trustStore})
+				_, _ = res.Write(body)
+			case req.Method == "POST" && path == "certificates/trust_store":
+				Expect(req.ParseMultipartForm(1 << 20)).To(Succeed())
+				file, _, err := req.FormFile("certificate_file")
+				Expect(err).To(BeNil())
+				data, _ := io.ReadAll(file)
+				block, _ := pem.Decode(data)
+				Expect(block).ToNot(BeNil())
+				sum := sha256.Sum256(block.Bytes)
+				qm.uploads++
+				entry := map[string]interface{}{
+					"id":                 fmt.Sprintf("trust%d", qm.uploads),
+					"label":              req.FormValue("label"),
+					"fingerprint_sha256": strings.ToUpper(hex.EncodeToString(sum[:])),
+				}
+				qm.trustStore = append(qm.trustStore, entry)
+				res.WriteHeader(201)
+				body, _ := json.Marshal(entry)
+				_, _ = res.Write(body)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	ref := func(id string) mqcloudv1.QueueManagerRef {
+		return mqcloudv1.QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuid, QueueManagerID: id}
+	}
+
+	It(`Uploads each default certificate to the peer trust store`, func() {
+		report, err := mqcloudService.TrustEachOther(ref("qma"), ref("qmb"))
+		Expect(err).To(BeNil())
+		Expect(report.Changed()).To(BeTrue())
+		Expect(report.Added).To(HaveLen(2))
+		Expect(report.AlreadyTrusted).To(BeEmpty())
+		Expect(queueManagers["qma"].trustStore).To(HaveLen(1))
+		Expect(queueManagers["qmb"].trustStore).To(HaveLen(1))
+		for _, change := range report.Added {
+			Expect(change.FingerprintSha256).To(Equal(fingerprints[change.Source.QueueManagerID]))
+			Expect(change.CertificateID).To(Equal("trust1"))
+			Expect(change.Label).To(HavePrefix(change.Source.QueueManagerID + ".example.com_"))
+		}
+	})
+	It(`Makes no changes when the queue managers already trust each other`, func() {
+		_, err := mqcloudService.TrustEachOther(ref("qma"), ref("qmb"))
+		Expect(err).To(BeNil())
+
+		report, err := mqcloudService.TrustEachOther(ref("qmb"), ref("qma"))
+		Expect(err).To(BeNil())
+		Expect(report.Changed()).To(BeFalse())
+		Expect(report.AlreadyTrusted).To(HaveLen(2))
+		Expect(queueManagers["qma"].uploads).To(Equal(1))
+		Expect(queueManagers["qmb"].uploads).To(Equal(1))
+	})
+	It(`Builds a full mesh`, func() {
+		report, err := mqcloudService.TrustMesh([]mqcloudv1.QueueManagerRef{ref("qma"), ref("qmb"), ref("qmc"), ref("qma")})
+		Expect(err).To(BeNil())
+		Expect(report.Added).To(HaveLen(6))
+		for _, qm := range queueManagers {
+			Expect(qm.trustStore).To(HaveLen(2))
+		}
+	})
+	It(`Fails when fewer than two queue managers are given`, func() {
+		report, err := mqcloudService.TrustEachOther(ref("qma"), ref("qma"))
+		Expect(err).ToNot(BeNil())
+		Expect(report).To(BeNil())
+	})
+	It(`Fails when the default certificate is not PEM`, func() {
+		queueManagers["qmb"].defaultPEM = []byte("not a certificate")
+		report, err := mqcloudService.TrustEachOther(ref("qma"), ref("qmb"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("qmb"))
+		Expect(report).To(BeNil())
+	})
+})
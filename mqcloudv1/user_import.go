@@ -0,0 +1,321 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultImportConcurrency is the number of CreateUser calls ImportUsers makes at once when
+// ImportUsersOptions.Concurrency is not set.
+const DefaultImportConcurrency = 4
+
+// Constants for UserImportResult.Status.
+const (
+	UserImportResult_Status_Created     = "created"
+	UserImportResult_Status_WouldCreate = "would_create"
+	UserImportResult_Status_Exists      = "exists"
+	UserImportResult_Status_Invalid     = "invalid"
+	UserImportResult_Status_Failed      = "failed"
+)
+
+// UserRecord : A user to provision.
+type UserRecord struct {
+	// The email of the user.
+	Email string `json:"email" yaml:"email"`
+
+	// The shortname of the user.
+	Name string `json:"shortname" yaml:"shortname"`
+}
+
+// Validate checks that the record has a plain email address and a shortname. The form of the
+// shortname is left to the service to check, as the API definition does not constrain it.
+func (record UserRecord) Validate() error {
+	address, err := mail.ParseAddress(record.Email)
+	if err != nil || address.Address != record.Email {
+		return fmt.Errorf("invalid email '%s'", record.Email)
+	}
+	if record.Name == "" {
+		return fmt.Errorf("missing shortname for '%s'", record.Email)
+	}
+	return nil
+}
+
+// ReadUserRecordsCSV reads user records from CSV. The first row must name the columns, which must
+// include "email" and "shortname" (or "name"); other columns are ignored. Values are trimmed.
+func ReadUserRecordsCSV(r io.Reader) (records []UserRecord, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "csv-header-error", common.GetComponentInfo())
+		return
+	}
+	emailColumn, nameColumn := -1, -1
+	for i, column := range header {
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "email":
+			emailColumn = i
+		case "shortname", "name":
+			nameColumn = i
+		}
+	}
+	if emailColumn < 0 || nameColumn < 0 {
+		err = core.SDKErrorf(nil, "CSV header must have 'email' and 'shortname' columns", "csv-header-error", common.GetComponentInfo())
+		return
+	}
+
+	for {
+		var row []string
+		row, err = reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, "", "csv-read-error", common.GetComponentInfo())
+			return nil, err
+		}
+		var record UserRecord
+		if emailColumn < len(row) {
+			record.Email = strings.TrimSpace(row[emailColumn])
+		}
+		if nameColumn < len(row) {
+			record.Name = strings.TrimSpace(row[nameColumn])
+		}
+		records = append(records, record)
+	}
+}
+
+// ReadUserRecordsYAML reads user records from a YAML sequence of mappings with "email" and
+// "shortname" keys.
+func ReadUserRecordsYAML(r io.Reader) (records []UserRecord, err error) {
+	err = yaml.NewDecoder(r).Decode(&records)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "yaml-read-error", common.GetComponentInfo())
+		return nil, err
+	}
+	for i := range records {
+		records[i].Email = strings.TrimSpace(records[i].Email)
+		records[i].Name = strings.TrimSpace(records[i].Name)
+	}
+	return
+}
+
+// ImportUsersOptions : The ImportUsers options.
+type ImportUsersOptions struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid *string `json:"service_instance_guid" validate:"required,ne="`
+
+	// The users to provision.
+	Records []UserRecord `json:"records" validate:"required"`
+
+	// The maximum number of CreateUser calls to make at once. Defaults to DefaultImportConcurrency.
+	Concurrency int `json:"concurrency,omitempty"`
+
+	// Report what would be done without creating any users.
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// UserImportResult : The outcome for one record.
+type UserImportResult struct {
+	// The position of the record in ImportUsersOptions.Records.
+	Index int `json:"index"`
+
+	// The record.
+	Record UserRecord `json:"record"`
+
+	// One of the UserImportResult_Status_* constants.
+	Status string `json:"status"`
+
+	// The ID of the created or existing user.
+	UserID string `json:"user_id,omitempty"`
+
+	// Why the record is invalid or failed.
+	Message string `json:"message,omitempty"`
+}
+
+// UserImportReport : The outcome of ImportUsers, one result per record in input order.
+type UserImportReport struct {
+	// True if no users were created.
+	DryRun bool `json:"dry_run"`
+
+	// The results, in the order of the records.
+	Results []UserImportResult `json:"results"`
+}
+
+// Counts returns the number of results with each status.
+func (report *UserImportReport) Counts() map[string]int {
+	counts := make(map[string]int)
+	for _, result := range report.Results {
+		counts[result.Status]++
+	}
+	return counts
+}
+
+// ImportUsers : Provision users in bulk
+// Validates each record, skips users that already exist (matched by email through UsersPager), and
+// creates the rest with CreateUser at bounded concurrency. Failures of individual records are
+// reported in the result rather than returned as an error.
+func (mqcloud *MqcloudV1) ImportUsers(importUsersOptions *ImportUsersOptions) (report *UserImportReport, err error) {
+	report, err = mqcloud.ImportUsersWithContext(context.Background(), importUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ImportUsersWithContext is an alternate form of the ImportUsers method which supports a Context parameter
+func (mqcloud *MqcloudV1) ImportUsersWithContext(ctx context.Context, importUsersOptions *ImportUsersOptions) (report *UserImportReport, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "ImportUsers", QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuidOf(importUsersOptions)})
+	defer func() {
+		end(err)
+	}()
+
+	err = core.ValidateNotNil(importUsersOptions, "importUsersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(importUsersOptions, "importUsersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pager, err := mqcloud.NewUsersPager(&ListUsersOptions{
+		ServiceInstanceGuid: importUsersOptions.ServiceInstanceGuid,
+		Headers:             importUsersOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+	existing, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "user-list-error")
+		return
+	}
+	byEmail := make(map[string]UserDetails)
+	byName := make(map[string]UserDetails)
+	for _, user := range existing {
+		byEmail[strings.ToLower(core.StringNilMapper(user.Email))] = user
+		byName[core.StringNilMapper(user.Name)] = user
+	}
+
+	report = &UserImportReport{
+		DryRun:  importUsersOptions.DryRun,
+		Results: make([]UserImportResult, len(importUsersOptions.Records)),
+	}
+	var pending []int
+	seenEmail := make(map[string]int)
+	seenName := make(map[string]int)
+	for i, record := range importUsersOptions.Records {
+		result := &report.Results[i]
+		result.Index = i
+		result.Record = record
+		email := strings.ToLower(record.Email)
+
+		if user, ok := byEmail[email]; ok {
+			result.Status = UserImportResult_Status_Exists
+			result.UserID = core.StringNilMapper(user.ID)
+			if core.StringNilMapper(user.Name) != record.Name {
+				result.Message = fmt.Sprintf("existing user has shortname '%s'", core.StringNilMapper(user.Name))
+			}
+			continue
+		}
+		result.Status = UserImportResult_Status_Invalid
+		if validateErr := record.Validate(); validateErr != nil {
+			result.Message = validateErr.Error()
+			continue
+		}
+		if _, ok := byName[record.Name]; ok {
+			result.Message = fmt.Sprintf("shortname '%s' is used by another user", record.Name)
+			continue
+		}
+		if first, ok := seenEmail[email]; ok {
+			result.Message = fmt.Sprintf("duplicate of record %d", first)
+			continue
+		}
+		if first, ok := seenName[record.Name]; ok {
+			result.Message = fmt.Sprintf("shortname '%s' is also used by record %d", record.Name, first)
+			continue
+		}
+		seenEmail[email] = i
+		seenName[record.Name] = i
+
+		result.Status = UserImportResult_Status_WouldCreate
+		pending = append(pending, i)
+	}
+	if importUsersOptions.DryRun {
+		return
+	}
+
+	concurrency := importUsersOptions.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultImportConcurrency
+	}
+	slots := make(chan struct{}, concurrency)
+	var wait sync.WaitGroup
+	for _, i := range pending {
+		result := &report.Results[i]
+		if ctx.Err() != nil {
+			result.Status = UserImportResult_Status_Failed
+			result.Message = ctx.Err().Error()
+			continue
+		}
+		slots <- struct{}{}
+		wait.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wait.Done()
+			}()
+			user, _, createErr := mqcloud.CreateUserWithContext(ctx, &CreateUserOptions{
+				ServiceInstanceGuid: importUsersOptions.ServiceInstanceGuid,
+				Email:               core.StringPtr(result.Record.Email),
+				Name:                core.StringPtr(result.Record.Name),
+				Headers:             importUsersOptions.Headers,
+			})
+			if createErr != nil {
+				result.Status = UserImportResult_Status_Failed
+				result.Message = createErr.Error()
+				return
+			}
+			result.Status = UserImportResult_Status_Created
+			if user != nil {
+				result.UserID = core.StringNilMapper(user.ID)
+			}
+		}()
+	}
+	wait.Wait()
+	return
+}
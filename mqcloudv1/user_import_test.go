@@ -0,0 +1,227 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// userTestServer is a stateful fake of the users API that pages two users at a time.
+type userTestServer struct {
+	*httptest.Server
+	mutex       sync.Mutex
+	users       map[string]map[string]string
+	nextID      int
+	fail        map[string]bool
+	creates     int
+	deletes     []string
+	listCalls   int
+	inFlight    int
+	maxInFlight int
+}
+
+func newUserTestServer(serviceInstanceGuid string, users ...[2]string) *userTestServer {
+	server := &userTestServer{users: map[string]map[string]string{}, fail: map[string]bool{}}
+	for _, user := range users {
+		server.add(user[0], user[1])
+	}
+	prefix := "/v1/" + serviceInstanceGuid + "/users"
+	server.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+		res.Header().Set("Content-type", "application/json")
+		switch {
+		case req.Method == "GET" && req.URL.Path == prefix:
+			server.list(res, req)
+		case req.Method == "POST" && req.URL.Path == prefix:
+			server.create(res, req)
+		case req.Method == "DELETE" && strings.HasPrefix(req.URL.Path, prefix+"/"):
+			server.mutex.Lock()
+			defer server.mutex.Unlock()
+			id := strings.TrimPrefix(req.URL.Path, prefix+"/")
+			if _, ok := server.users[id]; !ok {
+				res.WriteHeader(404)
+				return
+			}
+			delete(server.users, id)
+			server.deletes = append(server.deletes, id)
+			res.WriteHeader(204)
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+	return server
+}
+
+func (server *userTestServer) add(email string, name string) string {
+	server.nextID++
+	id := fmt.Sprintf("user%02d", server.nextID)
+	server.users[id] = map[string]string{"id": id, "email": email, "name": name, "href": "Href"}
+	return id
+}
+
+func (server *userTestServer) list(res http.ResponseWriter, req *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.listCalls++
+	ids := make([]string, 0, len(server.users))
+	for id := range server.users {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+	page := map[string]interface{}{"offset": offset, "limit": 2, "first": map[string]string{"href": req.URL.Path + "?offset=0"}}
+	users := []map[string]string{}
+	for i := offset; i < len(ids) && i < offset+2; i++ {
+		users = append(users, server.users[ids[i]])
+	}
+	page["users"] = users
+	if offset+2 < len(ids) {
+		page["next"] = map[string]string{"href": fmt.Sprintf("%s?offset=%d", req.URL.Path, offset+2)}
+	}
+	body, _ := json.Marshal(page)
+	_, _ = res.Write(body)
+}
+
+func (server *userTestServer) create(res http.ResponseWriter, req *http.Request) {
+	var body map[string]string
+	Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+
+	server.mutex.Lock()
+	server.inFlight++
+	if server.inFlight > server.maxInFlight {
+		server.maxInFlight = server.inFlight
+	}
+	server.mutex.Unlock()
+	time.Sleep(10 * time.Millisecond)
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.inFlight--
+	server.creates++
+	if server.fail[body["email"]] {
+		res.WriteHeader(500)
+		fmt.Fprint(res, `{"errors":[{"code":"internal_error","message":"boom"}]}`)
+		return
+	}
+	id := server.add(body["email"], body["name"])
+	res.WriteHeader(201)
+	out, _ := json.Marshal(server.users[id])
+	_, _ = res.Write(out)
+}
+
+var _ = Describe(`ImportUsers`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		server         *userTestServer
+		mqcloudService *mqcloudv1.MqcloudV1
+	)
+
+	BeforeEach(func() {
+		server = newUserTestServer(serviceInstanceGuid,
+			[2]string{"alice@example.com", "alice"},
+			[2]string{"bob@example.com", "bob"},
+			[2]string{"carol@example.com", "carol"})
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	records := func() []mqcloudv1.UserRecord {
+		csv := "email,shortname,team\n" +
+			"Carol@example.com,carol,ops\n" +
+			"dave@example.com,dave,ops\n" +
+			"not-an-email,erin,ops\n" +
+			"frank@example.com,,ops\n" +
+			"gina@example.com,bob,ops\n" +
+			"dave@example.com,dave2,ops\n" +
+			"harry@example.com,harry,ops\n" +
+			"ivan@example.com,ivan,ops\n" +
+			"judy@example.com,judy,ops\n" +
+			"kim@example.com,kim,ops\n"
+		records, err := mqcloudv1.ReadUserRecordsCSV(strings.NewReader(csv))
+		Expect(err).To(BeNil())
+		return records
+	}
+
+	statuses := func(report *mqcloudv1.UserImportReport) (out []string) {
+		for _, result := range report.Results {
+			out = append(out, result.Status)
+		}
+		return
+	}
+
+	It(`Reports what would be done in a dry run`, func() {
+		report, err := mqcloudService.ImportUsers(&mqcloudv1.ImportUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Records:             records(),
+			DryRun:              true,
+		})
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(statuses(report)).To(Equal([]string{"exists", "would_create", "invalid", "invalid", "invalid", "invalid", "would_create", "would_create", "would_create", "would_create"}))
+		Expect(report.Results[0].UserID).To(Equal("user03"))
+		Expect(report.Results[4].Message).To(ContainSubstring("used by another user"))
+		Expect(report.Results[5].Message).To(ContainSubstring("duplicate of record 1"))
+		Expect(server.creates).To(Equal(0))
+		Expect(server.listCalls).To(Equal(2))
+	})
+	It(`Creates missing users with bounded concurrency`, func() {
+		server.fail["ivan@example.com"] = true
+		report, err := mqcloudService.ImportUsers(&mqcloudv1.ImportUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Records:             records(),
+			Concurrency:         2,
+		})
+		Expect(err).To(BeNil())
+		Expect(statuses(report)).To(Equal([]string{"exists", "created", "invalid", "invalid", "invalid", "invalid", "created", "failed", "created", "created"}))
+		Expect(report.Counts()).To(Equal(map[string]int{"exists": 1, "created": 4, "invalid": 4, "failed": 1}))
+		Expect(report.Results[1].UserID).ToNot(BeEmpty())
+		Expect(report.Results[7].Message).ToNot(BeEmpty())
+		Expect(server.creates).To(Equal(5))
+		Expect(server.maxInFlight).To(BeNumerically("<=", 2))
+		Expect(server.maxInFlight).To(BeNumerically(">", 1))
+	})
+	It(`Reads YAML records`, func() {
+		records, err := mqcloudv1.ReadUserRecordsYAML(strings.NewReader("- email: dave@example.com\n  shortname: dave\n- email: ' erin@example.com '\n  shortname: erin\n"))
+		Expect(err).To(BeNil())
+		Expect(records).To(Equal([]mqcloudv1.UserRecord{{Email: "dave@example.com", Name: "dave"}, {Email: "erin@example.com", Name: "erin"}}))
+	})
+	It(`Rejects CSV without the required columns`, func() {
+		_, err := mqcloudv1.ReadUserRecordsCSV(strings.NewReader("mail,name\nx,y\n"))
+		Expect(err).ToNot(BeNil())
+	})
+})
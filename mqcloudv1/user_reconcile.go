@@ -0,0 +1,264 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+)
+
+// DefaultMaxUserDeletions is how many users a reconciliation may delete when
+// ReconcileUsersOptions.MaxDeletions is nil.
+const DefaultMaxUserDeletions = 5
+
+// ReconcileUsersOptions : The PlanUserReconciliation options.
+type ReconcileUsersOptions struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid *string `json:"service_instance_guid" validate:"required,ne="`
+
+	// The users who should have access. Users are matched by email, case insensitively; the shortname
+	// is only used to create missing users.
+	Desired []UserRecord `json:"desired"`
+
+	// Emails or shortnames of users that are never deleted, such as break-glass administrators.
+	Protected []string `json:"protected,omitempty"`
+
+	// The most users the plan may delete. Nil means DefaultMaxUserDeletions, zero allows no deletions
+	// and a negative value removes the limit.
+	MaxDeletions *int64 `json:"max_deletions,omitempty"`
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// UserReconciliationPlan : The changes that bring a service instance's users in line with a desired list.
+type UserReconciliationPlan struct {
+	// The GUID that uniquely identifies the MQ on Cloud service instance.
+	ServiceInstanceGuid string
+
+	// Users to create.
+	Create []UserRecord
+
+	// Users to delete.
+	Delete []UserDetails
+
+	// Users that are not in the desired list but are kept because they are protected.
+	Protected []UserDetails
+
+	// The number of desired users that already exist.
+	Unchanged int
+
+	// The deletion limit the plan was made with; negative for no limit.
+	MaxDeletions int
+
+	headers map[string]string
+}
+
+// Empty returns true if there is nothing to create or delete.
+func (plan *UserReconciliationPlan) Empty() bool {
+	return len(plan.Create) == 0 && len(plan.Delete) == 0
+}
+
+// ExceedsMaxDeletions returns true if the plan deletes more users than its limit allows.
+func (plan *UserReconciliationPlan) ExceedsMaxDeletions() bool {
+	return plan.MaxDeletions >= 0 && len(plan.Delete) > plan.MaxDeletions
+}
+
+// String renders the plan, one user per line: "+" for users to create, "-" for users to delete and
+// "=" for protected users that are kept.
+func (plan *UserReconciliationPlan) String() string {
+	var out strings.Builder
+	for _, record := range plan.Create {
+		fmt.Fprintf(&out, "+ %s (%s)\n", record.Email, record.Name)
+	}
+	for _, user := range plan.Delete {
+		fmt.Fprintf(&out, "- %s (%s)\n", core.StringNilMapper(user.Email), core.StringNilMapper(user.Name))
+	}
+	for _, user := range plan.Protected {
+		fmt.Fprintf(&out, "= %s (%s) protected\n", core.StringNilMapper(user.Email), core.StringNilMapper(user.Name))
+	}
+	fmt.Fprintf(&out, "%d to create, %d to delete, %d unchanged\n", len(plan.Create), len(plan.Delete), plan.Unchanged)
+	if plan.ExceedsMaxDeletions() {
+		fmt.Fprintf(&out, "! %d deletions exceeds the limit of %d\n", len(plan.Delete), plan.MaxDeletions)
+	}
+	return out.String()
+}
+
+// UserReconciliationResult : The outcome of ApplyUserReconciliation.
+type UserReconciliationResult struct {
+	// The outcome of creating the missing users.
+	Created *UserImportReport
+
+	// The users that were deleted.
+	Deleted []UserDetails
+}
+
+// PlanUserReconciliation : Plan the changes that make the instance users match a desired list
+// Compares the users of the service instance with the desired list and plans to create the missing
+// ones and delete the rest, except protected users. Nothing is changed; use ApplyUserReconciliation
+// to apply the plan.
+func (mqcloud *MqcloudV1) PlanUserReconciliation(reconcileUsersOptions *ReconcileUsersOptions) (plan *UserReconciliationPlan, err error) {
+	plan, err = mqcloud.PlanUserReconciliationWithContext(context.Background(), reconcileUsersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanUserReconciliationWithContext is an alternate form of the PlanUserReconciliation method which supports a Context parameter
+func (mqcloud *MqcloudV1) PlanUserReconciliationWithContext(ctx context.Context, reconcileUsersOptions *ReconcileUsersOptions) (plan *UserReconciliationPlan, err error) {
+	ctx, end := mqcloud.startSpan(ctx, "PlanUserReconciliation", QueueManagerRef{ServiceInstanceGuid: serviceInstanceGuidOf(reconcileUsersOptions)})
+	defer func() {
+		end(err)
+	}()
+
+	err = core.ValidateNotNil(reconcileUsersOptions, "reconcileUsersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(reconcileUsersOptions, "reconcileUsersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	desired := make(map[string]bool)
+	for _, record := range reconcileUsersOptions.Desired {
+		if err = record.Validate(); err != nil {
+			err = core.SDKErrorf(err, "", "invalid-desired-user", common.GetComponentInfo())
+			return
+		}
+		email := strings.ToLower(record.Email)
+		if desired[email] {
+			err = core.SDKErrorf(nil, fmt.Sprintf("user '%s' is listed more than once", record.Email), "duplicate-desired-user", common.GetComponentInfo())
+			return
+		}
+		desired[email] = true
+	}
+	protected := make(map[string]bool)
+	for _, entry := range reconcileUsersOptions.Protected {
+		protected[strings.ToLower(entry)] = true
+	}
+
+	pager, err := mqcloud.NewUsersPager(&ListUsersOptions{
+		ServiceInstanceGuid: reconcileUsersOptions.ServiceInstanceGuid,
+		Headers:             reconcileUsersOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+	users, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "user-list-error")
+		return
+	}
+
+	plan = &UserReconciliationPlan{
+		ServiceInstanceGuid: *reconcileUsersOptions.ServiceInstanceGuid,
+		MaxDeletions:        DefaultMaxUserDeletions,
+		headers:             reconcileUsersOptions.Headers,
+	}
+	if reconcileUsersOptions.MaxDeletions != nil {
+		plan.MaxDeletions = int(*reconcileUsersOptions.MaxDeletions)
+	}
+	existing := make(map[string]bool)
+	for _, user := range users {
+		email := strings.ToLower(core.StringNilMapper(user.Email))
+		existing[email] = true
+		switch {
+		case desired[email]:
+			plan.Unchanged++
+		case protected[email] || protected[strings.ToLower(core.StringNilMapper(user.Name))]:
+			plan.Protected = append(plan.Protected, user)
+		default:
+			plan.Delete = append(plan.Delete, user)
+		}
+	}
+	for _, record := range reconcileUsersOptions.Desired {
+		if !existing[strings.ToLower(record.Email)] {
+			plan.Create = append(plan.Create, record)
+		}
+	}
+	sort.SliceStable(plan.Delete, func(i, j int) bool {
+		return core.StringNilMapper(plan.Delete[i].Email) < core.StringNilMapper(plan.Delete[j].Email)
+	})
+	return
+}
+
+// ApplyUserReconciliation : Apply a user reconciliation plan
+// Creates the missing users of a plan from PlanUserReconciliation with ImportUsers, then deletes the
+// extra users. A plan that exceeds its deletion limit is rejected before any change is made. If any
+// user could not be created, no user is deleted and the result is returned with an error, so that a
+// failed reconciliation never leaves the instance with fewer users than it had. On a deletion error,
+// the result so far is returned with the error.
+func (mqcloud *MqcloudV1) ApplyUserReconciliation(plan *UserReconciliationPlan) (result *UserReconciliationResult, err error) {
+	result, err = mqcloud.ApplyUserReconciliationWithContext(context.Background(), plan)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyUserReconciliationWithContext is an alternate form of the ApplyUserReconciliation method which supports a Context parameter
+func (mqcloud *MqcloudV1) ApplyUserReconciliationWithContext(ctx context.Context, plan *UserReconciliationPlan) (result *UserReconciliationResult, err error) {
+	err = core.ValidateNotNil(plan, "plan cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	ctx, end := mqcloud.startSpan(ctx, "ApplyUserReconciliation", QueueManagerRef{ServiceInstanceGuid: plan.ServiceInstanceGuid})
+	defer func() {
+		end(err)
+	}()
+	if plan.ExceedsMaxDeletions() {
+		err = core.SDKErrorf(nil, fmt.Sprintf("plan deletes %d users, more than the limit of %d", len(plan.Delete), plan.MaxDeletions), "max-deletions-exceeded", common.GetComponentInfo())
+		return
+	}
+
+	result = &UserReconciliationResult{}
+	if len(plan.Create) > 0 {
+		result.Created, err = mqcloud.ImportUsersWithContext(ctx, &ImportUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(plan.ServiceInstanceGuid),
+			Records:             plan.Create,
+			Headers:             plan.headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "user-create-error")
+			return
+		}
+		counts := result.Created.Counts()
+		if failed := counts[UserImportResult_Status_Failed] + counts[UserImportResult_Status_Invalid]; failed > 0 {
+			err = core.SDKErrorf(nil, fmt.Sprintf("%d of %d users could not be created; no users were deleted", failed, len(plan.Create)), "user-create-error", common.GetComponentInfo())
+			return
+		}
+	}
+	for _, user := range plan.Delete {
+		_, err = mqcloud.DeleteUserWithContext(ctx, &DeleteUserOptions{
+			ServiceInstanceGuid: core.StringPtr(plan.ServiceInstanceGuid),
+			UserID:              user.ID,
+			Headers:             plan.headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "user-delete-error")
+			return
+		}
+		result.Deleted = append(result.Deleted, user)
+	}
+	return
+}
@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`User reconciliation`, func() {
+	const serviceInstanceGuid = "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8"
+	var (
+		server         *userTestServer
+		mqcloudService *mqcloudv1.MqcloudV1
+	)
+
+	BeforeEach(func() {
+		server = newUserTestServer(serviceInstanceGuid,
+			[2]string{"alice@example.com", "alice"},
+			[2]string{"bob@example.com", "bob"},
+			[2]string{"carol@example.com", "carol"},
+			[2]string{"admin@example.com", "breakglass"})
+
+		var err error
+		mqcloudService, err = mqcloudv1.NewMqcloudV1(&mqcloudv1.MqcloudV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Plans and applies creations and deletions`, func() {
+		plan, err := mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Desired:             []mqcloudv1.UserRecord{{Email: "ALICE@example.com", Name: "alice"}, {Email: "dave@example.com", Name: "dave"}},
+			Protected:           []string{"breakglass"},
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Create).To(Equal([]mqcloudv1.UserRecord{{Email: "dave@example.com", Name: "dave"}}))
+		Expect(plan.Delete).To(HaveLen(2))
+		Expect(*plan.Delete[0].Email).To(Equal("bob@example.com"))
+		Expect(plan.Protected).To(HaveLen(1))
+		Expect(plan.Unchanged).To(Equal(1))
+		Expect(plan.String()).To(Equal("+ dave@example.com (dave)\n" +
+			"- bob@example.com (bob)\n" +
+			"- carol@example.com (carol)\n" +
+			"= admin@example.com (breakglass) protected\n" +
+			"1 to create, 2 to delete, 1 unchanged\n"))
+		Expect(server.creates).To(Equal(0))
+		Expect(server.deletes).To(BeEmpty())
+
+		result, err := mqcloudService.ApplyUserReconciliation(plan)
+		Expect(err).To(BeNil())
+		Expect(result.Created.Counts()).To(Equal(map[string]int{"created": 1}))
+		Expect(result.Deleted).To(HaveLen(2))
+		Expect(server.deletes).To(Equal([]string{"user02", "user03"}))
+
+		plan, err = mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Desired:             []mqcloudv1.UserRecord{{Email: "alice@example.com", Name: "alice"}, {Email: "dave@example.com", Name: "dave"}},
+			Protected:           []string{"admin@example.com"},
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Empty()).To(BeTrue())
+	})
+	It(`Deletes no users when a creation fails`, func() {
+		server.fail["dave@example.com"] = true
+		plan, err := mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Desired:             []mqcloudv1.UserRecord{{Email: "alice@example.com", Name: "alice"}, {Email: "dave@example.com", Name: "dave"}, {Email: "erin@example.com", Name: "erin"}},
+			Protected:           []string{"breakglass"},
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Delete).To(HaveLen(2))
+
+		result, err := mqcloudService.ApplyUserReconciliation(plan)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("1 of 2 users could not be created"))
+		Expect(result.Created.Counts()).To(Equal(map[string]int{"created": 1, "failed": 1}))
+		Expect(result.Deleted).To(BeEmpty())
+		Expect(server.deletes).To(BeEmpty())
+	})
+	It(`Refuses to delete more users than allowed`, func() {
+		plan, err := mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			MaxDeletions:        core.Int64Ptr(2),
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Delete).To(HaveLen(4))
+		Expect(plan.ExceedsMaxDeletions()).To(BeTrue())
+		Expect(plan.String()).To(ContainSubstring("! 4 deletions exceeds the limit of 2"))
+
+		_, err = mqcloudService.ApplyUserReconciliation(plan)
+		Expect(err).ToNot(BeNil())
+		Expect(server.deletes).To(BeEmpty())
+
+		plan, err = mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			MaxDeletions:        core.Int64Ptr(-1),
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.ExceedsMaxDeletions()).To(BeFalse())
+
+		plan, err = mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.MaxDeletions).To(Equal(mqcloudv1.DefaultMaxUserDeletions))
+	})
+	It(`Deletes nobody when the limit is zero`, func() {
+		plan, err := mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Desired:             []mqcloudv1.UserRecord{{Email: "alice@example.com", Name: "alice"}, {Email: "dave@example.com", Name: "dave"}},
+			MaxDeletions:        core.Int64Ptr(0),
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.MaxDeletions).To(BeZero())
+		Expect(plan.Delete).To(HaveLen(3))
+		Expect(plan.ExceedsMaxDeletions()).To(BeTrue())
+
+		_, err = mqcloudService.ApplyUserReconciliation(plan)
+		Expect(err).ToNot(BeNil())
+		Expect(server.creates).To(Equal(0))
+		Expect(server.deletes).To(BeEmpty())
+
+		plan, err = mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Desired:             []mqcloudv1.UserRecord{{Email: "alice@example.com", Name: "alice"}, {Email: "dave@example.com", Name: "dave"}},
+			Protected:           []string{"bob", "carol", "breakglass"},
+			MaxDeletions:        core.Int64Ptr(0),
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.ExceedsMaxDeletions()).To(BeFalse())
+		result, err := mqcloudService.ApplyUserReconciliation(plan)
+		Expect(err).To(BeNil())
+		Expect(result.Created.Counts()).To(Equal(map[string]int{"created": 1}))
+		Expect(server.deletes).To(BeEmpty())
+	})
+	It(`Rejects an invalid desired list`, func() {
+		_, err := mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Desired:             []mqcloudv1.UserRecord{{Email: "dave@example.com", Name: "dave"}, {Email: "Dave@example.com", Name: "dave2"}},
+		})
+		Expect(err).ToNot(BeNil())
+		_, err = mqcloudService.PlanUserReconciliation(&mqcloudv1.ReconcileUsersOptions{
+			ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+			Desired:             []mqcloudv1.UserRecord{{Email: "dave", Name: "dave"}},
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,22 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mqcloudv1fakes provides a programmable fake of the mqcloudv1.MqcloudV1API interface for unit
+// tests. Each method records its calls and returns values set with the Returns, ReturnsOnCall or Calls
+// methods for that operation.
+package mqcloudv1fakes
+
+//go:generate go run generate.go
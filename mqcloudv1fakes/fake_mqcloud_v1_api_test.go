@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mqcloudv1fakes_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// queueManagerState is an example of code that depends on the interface rather than the client.
+func queueManagerState(ctx context.Context, client mqcloudv1.MqcloudV1API, serviceInstanceGuid string, queueManagerID string) (string, error) {
+	status, _, err := client.GetQueueManagerStatusWithContext(ctx, &mqcloudv1.GetQueueManagerStatusOptions{
+		ServiceInstanceGuid: core.StringPtr(serviceInstanceGuid),
+		QueueManagerID:      core.StringPtr(queueManagerID),
+	})
+	if err != nil {
+		return "", err
+	}
+	return *status.Status, nil
+}
+
+var _ = Describe(`FakeMqcloudV1API`, func() {
+	var fake *mqcloudv1fakes.FakeMqcloudV1API
+
+	BeforeEach(func() {
+		fake = &mqcloudv1fakes.FakeMqcloudV1API{}
+	})
+
+	It(`Returns zero values when nothing is stubbed`, func() {
+		result, response, err := fake.ListUsers(nil)
+		Expect(result).To(BeNil())
+		Expect(response).To(BeNil())
+		Expect(err).To(BeNil())
+		Expect(fake.ListUsersCallCount()).To(Equal(1))
+	})
+	It(`Returns stubbed values and records arguments`, func() {
+		fake.GetQueueManagerStatusWithContextReturns(&mqcloudv1.QueueManagerStatus{Status: core.StringPtr("running")}, &core.DetailedResponse{StatusCode: 200}, nil)
+		fake.GetQueueManagerStatusWithContextReturnsOnCall(1, nil, nil, errors.New("unavailable"))
+
+		ctx := context.WithValue(context.Background(), struct{}{}, "marker")
+		state, err := queueManagerState(ctx, fake, "guid", "qm1")
+		Expect(err).To(BeNil())
+		Expect(state).To(Equal("running"))
+		_, err = queueManagerState(ctx, fake, "guid", "qm1")
+		Expect(err).To(MatchError("unavailable"))
+		state, err = queueManagerState(ctx, fake, "guid", "qm2")
+		Expect(err).To(BeNil())
+		Expect(state).To(Equal("running"))
+
+		Expect(fake.GetQueueManagerStatusWithContextCallCount()).To(Equal(3))
+		callCtx, options := fake.GetQueueManagerStatusWithContextArgsForCall(2)
+		Expect(callCtx).To(Equal(ctx))
+		Expect(*options.QueueManagerID).To(Equal("qm2"))
+		Expect(fake.GetQueueManagerStatusCallCount()).To(Equal(0))
+		Expect(fake.Invocations()["GetQueueManagerStatusWithContext"]).To(HaveLen(3))
+	})
+	It(`Calls a stub in place of the method`, func() {
+		fake.DeleteUserCalls(func(options *mqcloudv1.DeleteUserOptions) (*core.DetailedResponse, error) {
+			if *options.UserID == "protected" {
+				return &core.DetailedResponse{StatusCode: 403}, errors.New("forbidden")
+			}
+			return &core.DetailedResponse{StatusCode: 204}, nil
+		})
+		response, err := fake.DeleteUser(&mqcloudv1.DeleteUserOptions{UserID: core.StringPtr("user01")})
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+		response, err = fake.DeleteUser(&mqcloudv1.DeleteUserOptions{UserID: core.StringPtr("protected")})
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(403))
+	})
+})
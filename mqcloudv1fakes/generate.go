@@ -0,0 +1,302 @@
+//go:build ignore
+
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// This program generates fake_mqcloud_v1_api.go from the MqcloudV1API interface.
+// Run it with "go generate" in this directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	source      = "../mqcloudv1/mqcloud_v1_api.go"
+	destination = "fake_mqcloud_v1_api.go"
+)
+
+type value struct {
+	Name string
+	Type string
+}
+
+type method struct {
+	Name    string
+	Field   string
+	Params  []value
+	Results []value
+}
+
+func main() {
+	header, err := os.ReadFile(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, source, header, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var methods []method
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.TypeSpec)
+		if !ok || spec.Name.Name != "MqcloudV1API" {
+			return true
+		}
+		for _, field := range spec.Type.(*ast.InterfaceType).Methods.List {
+			signature := field.Type.(*ast.FuncType)
+			m := method{Name: field.Names[0].Name}
+			m.Field = string(unicode.ToLower(rune(m.Name[0]))) + m.Name[1:]
+			for i, t := range expand(signature.Params) {
+				m.Params = append(m.Params, value{Name: fmt.Sprintf("arg%d", i+1), Type: t})
+			}
+			for i, t := range expand(signature.Results) {
+				m.Results = append(m.Results, value{Name: fmt.Sprintf("result%d", i+1), Type: t})
+			}
+			methods = append(methods, m)
+		}
+		return false
+	})
+
+	license := string(header[:bytes.Index(header, []byte("*/"))+2])
+	var out bytes.Buffer
+	if err = fakeTemplate.Execute(&out, struct {
+		License string
+		Methods []method
+	}{license, methods}); err != nil {
+		log.Fatal(err)
+	}
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(destination, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// expand returns the qualified type of each parameter or result in a field list.
+func expand(fields *ast.FieldList) (types []string) {
+	if fields == nil {
+		return
+	}
+	for _, field := range fields.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			types = append(types, qualify(field.Type))
+		}
+	}
+	return
+}
+
+// qualify renders a type expression from package mqcloudv1 so that it can be used from another package.
+func qualify(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "mqcloudv1." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + qualify(t.X)
+	case *ast.ArrayType:
+		return "[]" + qualify(t.Elt)
+	case *ast.MapType:
+		return "map[" + qualify(t.Key) + "]" + qualify(t.Value)
+	case *ast.SelectorExpr:
+		return t.X.(*ast.Ident).Name + "." + t.Sel.Name
+	}
+	log.Fatalf("unsupported type %T", expr)
+	return ""
+}
+
+var fakeTemplate = template.Must(template.New("fake").Funcs(template.FuncMap{
+	"params": func(values []value) string {
+		var parts []string
+		for _, v := range values {
+			parts = append(parts, v.Name+" "+v.Type)
+		}
+		return strings.Join(parts, ", ")
+	},
+	"types": func(values []value) string {
+		var parts []string
+		for _, v := range values {
+			parts = append(parts, v.Type)
+		}
+		return strings.Join(parts, ", ")
+	},
+	"names": func(values []value, prefix string) string {
+		var parts []string
+		for _, v := range values {
+			parts = append(parts, prefix+v.Name)
+		}
+		return strings.Join(parts, ", ")
+	},
+}).Parse(`{{.License}}
+
+// Code generated by generate.go. DO NOT EDIT.
+
+package mqcloudv1fakes
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+)
+
+// FakeMqcloudV1API : A fake implementation of mqcloudv1.MqcloudV1API.
+// For each method X, XStub (or XCalls) replaces the method's behaviour, XReturns and XReturnsOnCall
+// set the values it returns, and XCallCount and XArgsForCall report how it was called. The zero value
+// is ready to use and returns zero values from every method.
+type FakeMqcloudV1API struct {
+{{- range .Methods}}
+	{{.Name}}Stub func({{types .Params}}) ({{types .Results}})
+	{{.Field}}Mutex sync.RWMutex
+	{{.Field}}ArgsForCall []struct {
+{{- range .Params}}
+		{{.Name}} {{.Type}}
+{{- end}}
+	}
+	{{.Field}}Returns struct {
+{{- range .Results}}
+		{{.Name}} {{.Type}}
+{{- end}}
+	}
+	{{.Field}}ReturnsOnCall map[int]struct {
+{{- range .Results}}
+		{{.Name}} {{.Type}}
+{{- end}}
+	}
+{{- end}}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+{{range .Methods}}
+// {{.Name}} records the call and returns the values set for it.
+func (fake *FakeMqcloudV1API) {{.Name}}({{params .Params}}) ({{types .Results}}) {
+	fake.{{.Field}}Mutex.Lock()
+	ret, specificReturn := fake.{{.Field}}ReturnsOnCall[len(fake.{{.Field}}ArgsForCall)]
+	fake.{{.Field}}ArgsForCall = append(fake.{{.Field}}ArgsForCall, struct {
+{{- range .Params}}
+		{{.Name}} {{.Type}}
+{{- end}}
+	}{ {{- names .Params ""}}})
+	stub := fake.{{.Name}}Stub
+	fakeReturns := fake.{{.Field}}Returns
+	fake.recordInvocation("{{.Name}}", []interface{}{ {{- names .Params ""}}})
+	fake.{{.Field}}Mutex.Unlock()
+	if stub != nil {
+		return stub({{names .Params ""}})
+	}
+	if specificReturn {
+		return {{names .Results "ret."}}
+	}
+	return {{names .Results "fakeReturns."}}
+}
+
+// {{.Name}}CallCount returns the number of times {{.Name}} has been called.
+func (fake *FakeMqcloudV1API) {{.Name}}CallCount() int {
+	fake.{{.Field}}Mutex.RLock()
+	defer fake.{{.Field}}Mutex.RUnlock()
+	return len(fake.{{.Field}}ArgsForCall)
+}
+
+// {{.Name}}Calls sets a function to be called in place of {{.Name}}.
+func (fake *FakeMqcloudV1API) {{.Name}}Calls(stub func({{types .Params}}) ({{types .Results}})) {
+	fake.{{.Field}}Mutex.Lock()
+	defer fake.{{.Field}}Mutex.Unlock()
+	fake.{{.Name}}Stub = stub
+}
+
+// {{.Name}}ArgsForCall returns the arguments of call i to {{.Name}}, counting from zero.
+func (fake *FakeMqcloudV1API) {{.Name}}ArgsForCall(i int) ({{types .Params}}) {
+	fake.{{.Field}}Mutex.RLock()
+	defer fake.{{.Field}}Mutex.RUnlock()
+	argsForCall := fake.{{.Field}}ArgsForCall[i]
+	return {{names .Params "argsForCall."}}
+}
+
+// {{.Name}}Returns sets the values returned by {{.Name}}.
+func (fake *FakeMqcloudV1API) {{.Name}}Returns({{params .Results}}) {
+	fake.{{.Field}}Mutex.Lock()
+	defer fake.{{.Field}}Mutex.Unlock()
+	fake.{{.Name}}Stub = nil
+	fake.{{.Field}}Returns = struct {
+{{- range .Results}}
+		{{.Name}} {{.Type}}
+{{- end}}
+	}{ {{- names .Results ""}}}
+}
+
+// {{.Name}}ReturnsOnCall sets the values returned by call i to {{.Name}}, counting from zero.
+func (fake *FakeMqcloudV1API) {{.Name}}ReturnsOnCall(i int, {{params .Results}}) {
+	fake.{{.Field}}Mutex.Lock()
+	defer fake.{{.Field}}Mutex.Unlock()
+	fake.{{.Name}}Stub = nil
+	if fake.{{.Field}}ReturnsOnCall == nil {
+		fake.{{.Field}}ReturnsOnCall = make(map[int]struct {
+{{- range .Results}}
+			{{.Name}} {{.Type}}
+{{- end}}
+		})
+	}
+	fake.{{.Field}}ReturnsOnCall[i] = struct {
+{{- range .Results}}
+		{{.Name}} {{.Type}}
+{{- end}}
+	}{ {{- names .Results ""}}}
+}
+{{end}}
+// Invocations returns the arguments of every call made to the fake, keyed by method name.
+func (fake *FakeMqcloudV1API) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = append([][]interface{}(nil), value...)
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMqcloudV1API) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ mqcloudv1.MqcloudV1API = new(FakeMqcloudV1API)
+`))
@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsink
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+)
+
+// DefaultEnvPrefix is the variable name prefix used by EnvFileSink when none is given.
+const DefaultEnvPrefix = "MQCLOUD_APIKEY"
+
+// EnvFileSink : Stores an API key and its metadata as variables in an environment file.
+// The key is written as <Prefix>, and the metadata as <Prefix>_ID, <Prefix>_NAME, <Prefix>_APPLICATION_ID
+// and <Prefix>_CREATED_AT. Other variables already in the file are kept.
+type EnvFileSink struct {
+	// The file to write. It is replaced atomically on every store.
+	Path string
+
+	// The variable name prefix. Defaults to DefaultEnvPrefix.
+	Prefix string
+}
+
+// NewEnvFileSink returns a sink that writes variables starting with prefix to path.
+func NewEnvFileSink(path string, prefix string) *EnvFileSink {
+	return &EnvFileSink{Path: path, Prefix: prefix}
+}
+
+// StoreAPIKey implements mqcloudv1.SecretSink.
+func (sink *EnvFileSink) StoreAPIKey(ctx context.Context, apiKey string, metadata mqcloudv1.APIKeyMetadata) error {
+	prefix := sink.Prefix
+	if prefix == "" {
+		prefix = DefaultEnvPrefix
+	}
+	names := []string{prefix, prefix + "_ID", prefix + "_NAME", prefix + "_APPLICATION_ID", prefix + "_CREATED_AT"}
+	values := []string{apiKey, metadata.ApiKeyID, metadata.ApiKeyName, metadata.ApplicationID, metadata.CreatedAt.UTC().Format(time.RFC3339)}
+
+	existing, err := os.ReadFile(sink.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return core.SDKErrorf(err, "", "read-error", common.GetComponentInfo())
+	}
+
+	ours := make(map[string]bool, len(names))
+	for _, name := range names {
+		ours[name] = true
+	}
+	var out bytes.Buffer
+	scanner := bufio.NewScanner(bytes.NewReader(existing))
+	for scanner.Scan() {
+		if ours[envName(scanner.Text())] {
+			continue
+		}
+		out.WriteString(scanner.Text())
+		out.WriteByte('\n')
+	}
+	for i, name := range names {
+		fmt.Fprintf(&out, "%s=%s\n", name, envValue(values[i]))
+	}
+	return writeSecretFile(ctx, sink.Path, out.Bytes())
+}
+
+// HasAPIKey implements mqcloudv1.StoredAPIKeyReporter. It reports whether the file sets the key
+// variable and records the application as the key's owner.
+func (sink *EnvFileSink) HasAPIKey(ctx context.Context, applicationID string) (bool, error) {
+	prefix := sink.Prefix
+	if prefix == "" {
+		prefix = DefaultEnvPrefix
+	}
+	existing, err := os.ReadFile(sink.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, core.SDKErrorf(err, "", "read-error", common.GetComponentInfo())
+	}
+
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(existing))
+	for scanner.Scan() {
+		if name := envName(scanner.Text()); name != "" {
+			_, value, _ := strings.Cut(scanner.Text(), "=")
+			value = strings.TrimSpace(value)
+			if unquoted, unquoteErr := strconv.Unquote(value); unquoteErr == nil {
+				value = unquoted
+			}
+			values[name] = value
+		}
+	}
+	return values[prefix] != "" && values[prefix+"_APPLICATION_ID"] == applicationID, nil
+}
+
+// envValue leaves values made of characters that need no quoting as they are, since some consumers
+// (such as docker --env-file) do not strip quotes, and double quotes anything else.
+func envValue(value string) string {
+	for _, r := range value {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("_-.:/+=@", r)) {
+			return strconv.Quote(value)
+		}
+	}
+	return value
+}
+
+// envName returns the variable name assigned on an environment file line, or "" for blank lines and comments.
+func envName(line string) string {
+	line = strings.TrimPrefix(strings.TrimSpace(line), "export ")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ""
+	}
+	name, _, found := strings.Cut(line, "=")
+	if !found {
+		return ""
+	}
+	return strings.TrimSpace(name)
+}
@@ -0,0 +1,78 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsink_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/IBM/mqcloud-go-sdk/secretsink"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EnvFileSink`, func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "secretsink")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Replaces its own variables and keeps the others`, func() {
+		path := filepath.Join(dir, "app.env")
+		Expect(os.WriteFile(path, []byte("# settings\nQM_NAME=QM1\nexport MQCLOUD_APIKEY=old\nMQCLOUD_APIKEY_ID=oldid\n"), 0o600)).To(Succeed())
+
+		sink := secretsink.NewEnvFileSink(path, "")
+		Expect(sink.StoreAPIKey(context.Background(), "new-key", testMetadata)).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal("# settings\nQM_NAME=QM1\n" +
+			"MQCLOUD_APIKEY=new-key\n" +
+			"MQCLOUD_APIKEY_ID=key1\n" +
+			"MQCLOUD_APIKEY_NAME=rotated\n" +
+			"MQCLOUD_APIKEY_APPLICATION_ID=app1\n" +
+			"MQCLOUD_APIKEY_CREATED_AT=2024-06-01T12:00:00Z\n"))
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+	})
+	It(`Quotes values that need it and honours the prefix`, func() {
+		path := filepath.Join(dir, "app.env")
+		sink := secretsink.NewEnvFileSink(path, "APP1_KEY")
+		Expect(sink.StoreAPIKey(context.Background(), `has space"`, testMetadata)).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(HavePrefix(`APP1_KEY="has space\""` + "\n"))
+	})
+	It(`Reports whether it holds a key for the application`, func() {
+		sink := secretsink.NewEnvFileSink(filepath.Join(dir, "app.env"), "")
+		var reporter mqcloudv1.StoredAPIKeyReporter = sink
+		Expect(reporter.HasAPIKey(context.Background(), "app1")).To(BeFalse())
+		Expect(sink.StoreAPIKey(context.Background(), "secret-value", testMetadata)).To(Succeed())
+		Expect(reporter.HasAPIKey(context.Background(), "app1")).To(BeTrue())
+		Expect(reporter.HasAPIKey(context.Background(), "app2")).To(BeFalse())
+	})
+})
@@ -0,0 +1,111 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package secretsink : Implementations of mqcloudv1.SecretSink that store application API keys locally
+package secretsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+)
+
+// secretPermissions is the mode of every file a sink writes.
+const secretPermissions = 0o600
+
+// FileSink : Stores an API key and its metadata as a JSON document in a file only the current user can read.
+type FileSink struct {
+	// The file to write. It is replaced atomically on every store.
+	Path string
+}
+
+// fileSinkDocument is the JSON written by FileSink.
+type fileSinkDocument struct {
+	ApiKey string `json:"api_key"`
+	mqcloudv1.APIKeyMetadata
+}
+
+// NewFileSink returns a sink that writes to path.
+func NewFileSink(path string) *FileSink {
+	return &FileSink{Path: path}
+}
+
+// StoreAPIKey implements mqcloudv1.SecretSink.
+func (sink *FileSink) StoreAPIKey(ctx context.Context, apiKey string, metadata mqcloudv1.APIKeyMetadata) error {
+	data, err := json.MarshalIndent(fileSinkDocument{ApiKey: apiKey, APIKeyMetadata: metadata}, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	return writeSecretFile(ctx, sink.Path, append(data, '\n'))
+}
+
+// HasAPIKey implements mqcloudv1.StoredAPIKeyReporter. It reports whether the file holds a key for
+// the application.
+func (sink *FileSink) HasAPIKey(ctx context.Context, applicationID string) (bool, error) {
+	data, err := os.ReadFile(sink.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, core.SDKErrorf(err, "", "read-error", common.GetComponentInfo())
+	}
+	var document fileSinkDocument
+	if err = json.Unmarshal(data, &document); err != nil {
+		return false, core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+	}
+	return document.ApiKey != "" && document.ApplicationID == applicationID, nil
+}
+
+// writeSecretFile writes data to a new file with secretPermissions in the same directory as path,
+// syncs it and renames it over path, so that readers only ever see a complete file and the key is
+// never readable by other users, even briefly.
+func writeSecretFile(ctx context.Context, path string, data []byte) (err error) {
+	if err = ctx.Err(); err != nil {
+		return core.SDKErrorf(err, "", "context-error", common.GetComponentInfo())
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return core.SDKErrorf(err, "", "create-error", common.GetComponentInfo())
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+	if err = tmp.Chmod(secretPermissions); err != nil {
+		return core.SDKErrorf(err, "", "chmod-error", common.GetComponentInfo())
+	}
+	if _, err = tmp.Write(data); err != nil {
+		return core.SDKErrorf(err, "", "write-error", common.GetComponentInfo())
+	}
+	if err = tmp.Sync(); err != nil {
+		return core.SDKErrorf(err, "", "sync-error", common.GetComponentInfo())
+	}
+	if err = tmp.Close(); err != nil {
+		return core.SDKErrorf(err, "", "close-error", common.GetComponentInfo())
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return core.SDKErrorf(err, "", "rename-error", common.GetComponentInfo())
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsink_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/IBM/mqcloud-go-sdk/secretsink"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testMetadata is the key metadata stored by every sink test.
+var testMetadata = mqcloudv1.APIKeyMetadata{
+	ServiceInstanceGuid: "a2b4d4bc-dadb-4637-bcec-9b7d1e723af8",
+	ApplicationID:       "app1",
+	ApiKeyID:            "key1",
+	ApiKeyName:          "rotated",
+	CreatedAt:           time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+}
+
+var _ = Describe(`FileSink`, func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "secretsink")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Writes the key and metadata readable only by the owner`, func() {
+		path := filepath.Join(dir, "apikey.json")
+		Expect(os.WriteFile(path, []byte("old"), 0o644)).To(Succeed())
+
+		sink := secretsink.NewFileSink(path)
+		Expect(sink.StoreAPIKey(context.Background(), "secret-value", testMetadata)).To(Succeed())
+
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+		data, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		var document map[string]interface{}
+		Expect(json.Unmarshal(data, &document)).To(Succeed())
+		Expect(document).To(HaveKeyWithValue("api_key", "secret-value"))
+		Expect(document).To(HaveKeyWithValue("api_key_id", "key1"))
+		Expect(document).To(HaveKeyWithValue("api_key_name", "rotated"))
+		Expect(document).To(HaveKeyWithValue("created_at", "2024-06-01T12:00:00Z"))
+
+		entries, err := os.ReadDir(dir)
+		Expect(err).To(BeNil())
+		Expect(entries).To(HaveLen(1))
+	})
+	It(`Does not write when the context is done`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		path := filepath.Join(dir, "apikey.json")
+		Expect(secretsink.NewFileSink(path).StoreAPIKey(ctx, "secret-value", testMetadata)).ToNot(Succeed())
+		_, err := os.Stat(path)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+	It(`Reports whether it holds a key for the application`, func() {
+		sink := secretsink.NewFileSink(filepath.Join(dir, "apikey.json"))
+		var reporter mqcloudv1.StoredAPIKeyReporter = sink
+		Expect(reporter.HasAPIKey(context.Background(), "app1")).To(BeFalse())
+		Expect(sink.StoreAPIKey(context.Background(), "secret-value", testMetadata)).To(Succeed())
+		Expect(reporter.HasAPIKey(context.Background(), "app1")).To(BeTrue())
+		Expect(reporter.HasAPIKey(context.Background(), "app2")).To(BeFalse())
+	})
+})
@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/mqcloud-go-sdk/common"
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DefaultSecretKey is the data key KubernetesSecretSink stores the API key under when none is given.
+	DefaultSecretKey = "apikey"
+
+	// Annotations KubernetesSecretSink records the key metadata in.
+	AnnotationApiKeyID      = "mqcloud.ibm.com/api-key-id"
+	AnnotationApiKeyName    = "mqcloud.ibm.com/api-key-name"
+	AnnotationApplicationID = "mqcloud.ibm.com/application-id"
+	AnnotationCreatedAt     = "mqcloud.ibm.com/created-at"
+)
+
+// KubernetesSecretSink : Writes an API key as a Kubernetes Secret manifest, ready for kubectl apply.
+// The key metadata is recorded as annotations on the Secret.
+type KubernetesSecretSink struct {
+	// The manifest file to write. It is replaced atomically on every store.
+	Path string
+
+	// The name of the Secret.
+	Name string
+
+	// The namespace of the Secret. Omitted from the manifest when empty.
+	Namespace string
+
+	// The data key to store the API key under. Defaults to DefaultSecretKey.
+	Key string
+
+	// Extra labels to set on the Secret.
+	Labels map[string]string
+}
+
+// NewKubernetesSecretSink returns a sink that writes a manifest for the named Secret to path.
+func NewKubernetesSecretSink(path string, namespace string, name string) *KubernetesSecretSink {
+	return &KubernetesSecretSink{Path: path, Namespace: namespace, Name: name}
+}
+
+// kubernetesSecret is the subset of a core/v1 Secret that the sink writes.
+type kubernetesSecret struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name        string            `yaml:"name"`
+		Namespace   string            `yaml:"namespace,omitempty"`
+		Labels      map[string]string `yaml:"labels,omitempty"`
+		Annotations map[string]string `yaml:"annotations"`
+	} `yaml:"metadata"`
+	Type string            `yaml:"type"`
+	Data map[string]string `yaml:"data"`
+}
+
+// StoreAPIKey implements mqcloudv1.SecretSink.
+func (sink *KubernetesSecretSink) StoreAPIKey(ctx context.Context, apiKey string, metadata mqcloudv1.APIKeyMetadata) error {
+	if sink.Name == "" {
+		return core.SDKErrorf(nil, "a Secret name is required", "missing-secret-name", common.GetComponentInfo())
+	}
+	key := sink.Key
+	if key == "" {
+		key = DefaultSecretKey
+	}
+
+	secret := kubernetesSecret{APIVersion: "v1", Kind: "Secret", Type: "Opaque"}
+	secret.Metadata.Name = sink.Name
+	secret.Metadata.Namespace = sink.Namespace
+	secret.Metadata.Labels = sink.Labels
+	secret.Metadata.Annotations = map[string]string{
+		AnnotationApiKeyID:      metadata.ApiKeyID,
+		AnnotationApiKeyName:    metadata.ApiKeyName,
+		AnnotationApplicationID: metadata.ApplicationID,
+		AnnotationCreatedAt:     metadata.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	secret.Data = map[string]string{key: base64.StdEncoding.EncodeToString([]byte(apiKey))}
+
+	var manifest bytes.Buffer
+	encoder := yaml.NewEncoder(&manifest)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(secret); err != nil {
+		return core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	if err := encoder.Close(); err != nil {
+		return core.SDKErrorf(err, "", "marshal-error", common.GetComponentInfo())
+	}
+	return writeSecretFile(ctx, sink.Path, manifest.Bytes())
+}
+
+// HasAPIKey implements mqcloudv1.StoredAPIKeyReporter. It reports whether the manifest holds a key
+// annotated with the application id.
+func (sink *KubernetesSecretSink) HasAPIKey(ctx context.Context, applicationID string) (bool, error) {
+	key := sink.Key
+	if key == "" {
+		key = DefaultSecretKey
+	}
+	data, err := os.ReadFile(sink.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, core.SDKErrorf(err, "", "read-error", common.GetComponentInfo())
+	}
+	var secret kubernetesSecret
+	if err = yaml.Unmarshal(data, &secret); err != nil {
+		return false, core.SDKErrorf(err, "", "unmarshal-error", common.GetComponentInfo())
+	}
+	return secret.Data[key] != "" && secret.Metadata.Annotations[AnnotationApplicationID] == applicationID, nil
+}
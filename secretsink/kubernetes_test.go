@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package secretsink_test
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/mqcloud-go-sdk/mqcloudv1"
+	"github.com/IBM/mqcloud-go-sdk/secretsink"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe(`KubernetesSecretSink`, func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "secretsink")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Writes a Secret manifest with the metadata as annotations`, func() {
+		path := filepath.Join(dir, "secret.yaml")
+		sink := secretsink.NewKubernetesSecretSink(path, "mq", "app1-apikey")
+		sink.Labels = map[string]string{"app": "app1"}
+		Expect(sink.StoreAPIKey(context.Background(), "secret-value", testMetadata)).To(Succeed())
+
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+		data, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+
+		var manifest struct {
+			APIVersion string `yaml:"apiVersion"`
+			Kind       string `yaml:"kind"`
+			Type       string `yaml:"type"`
+			Metadata   struct {
+				Name        string            `yaml:"name"`
+				Namespace   string            `yaml:"namespace"`
+				Labels      map[string]string `yaml:"labels"`
+				Annotations map[string]string `yaml:"annotations"`
+			} `yaml:"metadata"`
+			Data map[string]string `yaml:"data"`
+		}
+		Expect(yaml.Unmarshal(data, &manifest)).To(Succeed())
+		Expect(manifest.APIVersion).To(Equal("v1"))
+		Expect(manifest.Kind).To(Equal("Secret"))
+		Expect(manifest.Type).To(Equal("Opaque"))
+		Expect(manifest.Metadata.Name).To(Equal("app1-apikey"))
+		Expect(manifest.Metadata.Namespace).To(Equal("mq"))
+		Expect(manifest.Metadata.Labels).To(HaveKeyWithValue("app", "app1"))
+		Expect(manifest.Metadata.Annotations).To(HaveKeyWithValue(secretsink.AnnotationApiKeyID, "key1"))
+		Expect(manifest.Metadata.Annotations).To(HaveKeyWithValue(secretsink.AnnotationApiKeyName, "rotated"))
+		Expect(manifest.Metadata.Annotations).To(HaveKeyWithValue(secretsink.AnnotationCreatedAt, "2024-06-01T12:00:00Z"))
+		Expect(manifest.Data).To(HaveKeyWithValue(secretsink.DefaultSecretKey, base64.StdEncoding.EncodeToString([]byte("secret-value"))))
+	})
+	It(`Requires a Secret name`, func() {
+		sink := secretsink.NewKubernetesSecretSink(filepath.Join(dir, "secret.yaml"), "", "")
+		Expect(sink.StoreAPIKey(context.Background(), "secret-value", testMetadata)).ToNot(Succeed())
+	})
+	It(`Reports whether it holds a key for the application`, func() {
+		sink := secretsink.NewKubernetesSecretSink(filepath.Join(dir, "secret.yaml"), "", "app1-apikey")
+		var reporter mqcloudv1.StoredAPIKeyReporter = sink
+		Expect(reporter.HasAPIKey(context.Background(), "app1")).To(BeFalse())
+		Expect(sink.StoreAPIKey(context.Background(), "secret-value", testMetadata)).To(Succeed())
+		Expect(reporter.HasAPIKey(context.Background(), "app1")).To(BeTrue())
+		Expect(reporter.HasAPIKey(context.Background(), "app2")).To(BeFalse())
+	})
+})